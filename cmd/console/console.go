@@ -1,7 +1,535 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/statistico/statistico-trader/internal/trader/bootstrap"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/statistico/statistico-trader/internal/trader/trade"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
 
 func main() {
-	fmt.Println("Hello to the console application")
+	if len(os.Args) < 2 {
+		fmt.Println("Hello to the console application")
+		return
+	}
+
+	app := bootstrap.BuildContainer(bootstrap.BuildConfig())
+
+	switch os.Args[1] {
+	case "backtest:compare":
+		compareBacktestRuns(app, os.Args[2:])
+	case "backtest:list":
+		listBacktestRuns(app, os.Args[2:])
+	case "backtest:show":
+		showBacktestRun(app, os.Args[2:])
+	case "fixture:status":
+		updateFixtureStatus(app, os.Args[2:])
+	case "strategy:clv":
+		closingLineValue(app, os.Args[2:])
+	case "strategy:montecarlo":
+		monteCarloStrategy(app, os.Args[2:])
+	case "strategy:optimise":
+		optimiseStrategy(app, os.Args[2:])
+	case "strategy:simulate":
+		simulateStrategy(app, os.Args[2:])
+	case "trade:settle":
+		settleTrades(app)
+	default:
+		fmt.Printf("Command %s is not supported\n", os.Args[1])
+		os.Exit(1)
+	}
+}
+
+// compareBacktestRuns prints the summary metrics of two stored backtest runs side by side, for example
+// "backtest:compare <run-id> <run-id>".
+func compareBacktestRuns(app bootstrap.Container, args []string) {
+	if len(args) != 2 {
+		fmt.Println("Usage: backtest:compare <run-id> <run-id>")
+		os.Exit(1)
+	}
+
+	first := fetchBacktestRun(app, args[0])
+	second := fetchBacktestRun(app, args[1])
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "METRIC\t%s\t%s\tDIFFERENCE\n", first.ID, second.ID)
+
+	for _, m := range strategy.CompareBacktestRuns(first, second) {
+		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%+.4f\n", m.Metric, m.First, m.Second, m.Difference)
+	}
+
+	w.Flush()
+}
+
+// listBacktestRuns prints the stored backtest runs for a user, most recent first.
+func listBacktestRuns(app bootstrap.Container, args []string) {
+	if len(args) != 1 {
+		fmt.Println("Usage: backtest:list <user-id>")
+		os.Exit(1)
+	}
+
+	userID, err := uuid.Parse(args[0])
+
+	if err != nil {
+		fmt.Printf("User ID %s is not valid\n", args[0])
+		os.Exit(1)
+	}
+
+	runs, err := app.StrategyBacktestReader().List(userID)
+
+	if err != nil {
+		app.Logger.Errorf("error fetching backtest runs for user %s: %+v", userID, err)
+		os.Exit(1)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tCREATED\tMARKET\tRUNNER\tSIDE\tTRADES\tNET PROFIT\tROI")
+
+	for _, r := range runs {
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\t%s\t%d\t%.2f\t%.2f\n",
+			r.ID,
+			r.CreatedAt.UTC().Format(time.RFC3339),
+			r.Query.Market,
+			r.Query.Runner,
+			r.Query.Side,
+			r.Summary.Trades,
+			r.Summary.NetProfit,
+			r.Summary.ROI,
+		)
+	}
+
+	w.Flush()
+}
+
+// showBacktestRun prints a stored backtest run including the query, summary and trades as JSON.
+func showBacktestRun(app bootstrap.Container, args []string) {
+	if len(args) != 1 {
+		fmt.Println("Usage: backtest:show <run-id>")
+		os.Exit(1)
+	}
+
+	out, err := json.MarshalIndent(fetchBacktestRun(app, args[0]), "", "  ")
+
+	if err != nil {
+		app.Logger.Errorf("error encoding backtest run: %+v", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(out))
+}
+
+// fetchBacktestRun returns the stored backtest run with the ID provided, exiting if it does not exist.
+func fetchBacktestRun(app bootstrap.Container, runID string) *strategy.BacktestRun {
+	id, err := uuid.Parse(runID)
+
+	if err != nil {
+		fmt.Printf("Backtest run ID %s is not valid\n", runID)
+		os.Exit(1)
+	}
+
+	run, err := app.StrategyBacktestReader().Get(id)
+
+	if err != nil {
+		app.Logger.Errorf("error fetching backtest run %s: %+v", id, err)
+		os.Exit(1)
+	}
+
+	if run == nil {
+		fmt.Printf("Backtest run %s does not exist\n", id)
+		os.Exit(1)
+	}
+
+	return run
+}
+
+// closingLineValue prints the closing line value of a saved strategy's settled live trades. If a line is provided
+// the strategy is also backtested using prices from that line, for example "strategy:clv <strategy-id> MAX". VOID
+// trades are excluded as they are from backtest summaries.
+func closingLineValue(app bootstrap.Container, args []string) {
+	if len(args) != 1 && len(args) != 2 {
+		fmt.Println("Usage: strategy:clv <strategy-id> [<line>]")
+		os.Exit(1)
+	}
+
+	st := fetchStrategy(app, args[0])
+
+	trades, err := app.TradeReader().Get(&trade.ReaderQuery{StrategyID: st.ID})
+
+	if err != nil {
+		app.Logger.Errorf("error fetching trades for strategy %s: %+v", st.ID, err)
+		os.Exit(1)
+	}
+
+	var live strategy.ClosingLineValue
+
+	for _, t := range trades {
+		if t.Result != trade.InPlay && t.Result != strategy.Void {
+			live.Add(t.Side, t.Price, t.ClosingPrice)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "SOURCE\tTRADES\tMISSING\tBEAT CLOSING LINE\tAVERAGE CLV")
+	printClosingLineValue(w, "LIVE", live)
+
+	if len(args) == 2 {
+		var backtest strategy.ClosingLineValue
+
+		for t := range app.StrategyBuilder().Build(context.Background(), strategy.NewBuilderQuery(st, args[1])) {
+			if t.Result != strategy.Void {
+				backtest.Add(t.Side, t.Price, t.ClosingPrice)
+			}
+		}
+
+		printClosingLineValue(w, "BACKTEST", backtest)
+	}
+
+	w.Flush()
+}
+
+func printClosingLineValue(w io.Writer, source string, c strategy.ClosingLineValue) {
+	fmt.Fprintf(
+		w,
+		"%s\t%d\t%d\t%.2f%%\t%+.2f%%\n",
+		source,
+		c.Trades,
+		c.Missing,
+		c.BeatClosingLineRate,
+		c.AverageCLV,
+	)
+}
+
+// monteCarloStrategy backtests a saved strategy and runs a Monte Carlo simulation of the trades using the
+// strategy's staking plan, for example "strategy:montecarlo <strategy-id> CLOSING 1000 10000 SHUFFLE 50" to count
+// paths that lose half of the bankroll as ruined.
+func monteCarloStrategy(app bootstrap.Container, args []string) {
+	if len(args) < 5 || len(args) > 6 {
+		fmt.Println("Usage: strategy:montecarlo <strategy-id> <line> <bankroll> <iterations> <method> [<ruin-percentage>]")
+		os.Exit(1)
+	}
+
+	bankroll, err := strconv.ParseFloat(args[2], 32)
+
+	if err != nil {
+		fmt.Printf("Bankroll %s is not valid\n", args[2])
+		os.Exit(1)
+	}
+
+	iterations, err := strconv.Atoi(args[3])
+
+	if err != nil {
+		fmt.Printf("Iterations %s is not valid\n", args[3])
+		os.Exit(1)
+	}
+
+	opts := strategy.MonteCarloOptions{
+		Iterations: iterations,
+		Method:     args[4],
+		Seed:       app.Clock.Now().UnixNano(),
+	}
+
+	if len(args) == 6 {
+		if opts.RuinPercentage, err = strconv.ParseFloat(args[5], 64); err != nil {
+			fmt.Printf("Ruin percentage %s is not valid\n", args[5])
+			os.Exit(1)
+		}
+	}
+
+	st := fetchStrategy(app, args[0])
+
+	var trades []*strategy.Trade
+
+	for t := range app.StrategyBuilder().Build(context.Background(), strategy.NewBuilderQuery(st, args[1])) {
+		trades = append(trades, t)
+	}
+
+	sim, err := strategy.SimulateMonteCarlo(
+		trades,
+		st.StakingPlan,
+		float32(bankroll),
+		app.StrategyCommission(),
+		st.UserID.String(),
+		opts,
+	)
+
+	if err != nil {
+		fmt.Printf("Error running monte carlo simulation: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	out, err := json.MarshalIndent(sim, "", "  ")
+
+	if err != nil {
+		app.Logger.Errorf("error encoding monte carlo simulation: %+v", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(out))
+}
+
+// optimiseStrategy backtests a saved strategy for every combination of the parameters in a JSON file and prints
+// the results ranked using the optional rank by argument, for example
+// "strategy:optimise <strategy-id> CLOSING params.json NET_PROFIT" where params.json contains
+// [{"name": "MIN_ODDS", "from": 1.5, "to": 2.5, "step": 0.25}, {"name": "STAT_FILTER_GAMES", "filter": 0, "values": [3, 5]}].
+// Combinations settling fewer trades than the backtest minimum sample are ranked last and marked with an asterisk.
+func optimiseStrategy(app bootstrap.Container, args []string) {
+	if len(args) != 3 && len(args) != 4 {
+		fmt.Println("Usage: strategy:optimise <strategy-id> <line> <parameters-file> [<rank-by>]")
+		os.Exit(1)
+	}
+
+	st := fetchStrategy(app, args[0])
+
+	b, err := os.ReadFile(args[2])
+
+	if err != nil {
+		fmt.Printf("Unable to read parameters file %s: %s\n", args[2], err.Error())
+		os.Exit(1)
+	}
+
+	var params []*strategy.SweepParameter
+
+	if err := json.Unmarshal(b, &params); err != nil {
+		fmt.Printf("Parameters file %s is not valid: %s\n", args[2], err.Error())
+		os.Exit(1)
+	}
+
+	query := strategy.OptimiserQuery{
+		Query:      strategy.NewBuilderQuery(st, args[1]),
+		Parameters: params,
+		Commission: app.StrategyCommission(),
+		UserID:     st.UserID.String(),
+	}
+
+	if len(args) == 4 {
+		query.RankBy = args[3]
+	}
+
+	results, err := app.StrategyOptimiser().Optimise(context.Background(), &query)
+
+	if err != nil {
+		fmt.Printf("Error optimising strategy %s: %s\n", st.ID, err.Error())
+		os.Exit(1)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "RANK\tPARAMETERS\tTRADES\tSTRIKE RATE\tNET PROFIT\tROI\tYIELD\tMAX DRAWDOWN")
+
+	for i, r := range results {
+		values := []string{}
+
+		for _, p := range r.Parameters {
+			values = append(values, fmt.Sprintf("%s[%d]=%g", p.Name, p.Filter, p.Value))
+		}
+
+		p := r.Performance
+		trades := strconv.Itoa(p.Trades)
+
+		if r.InsufficientSample {
+			trades += "*"
+		}
+
+		fmt.Fprintf(
+			w,
+			"%d\t%s\t%s\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\n",
+			i+1,
+			strings.Join(values, " "),
+			trades,
+			p.StrikeRate,
+			p.NetProfit,
+			p.ROI,
+			p.Yield,
+			p.MaxDrawdown,
+		)
+	}
+
+	w.Flush()
+}
+
+// simulateStrategy backtests a saved strategy and replays the trades against a starting bankroll using the
+// strategy's staking plan followed by any additional plans provided to compare against, for example
+// "strategy:simulate <strategy-id> CLOSING 1000 PERCENTAGE:5 FIXED:20".
+func simulateStrategy(app bootstrap.Container, args []string) {
+	if len(args) < 3 {
+		fmt.Println("Usage: strategy:simulate <strategy-id> <line> <bankroll> [<plan>:<value> ...]")
+		os.Exit(1)
+	}
+
+	bankroll, err := strconv.ParseFloat(args[2], 32)
+
+	if err != nil {
+		fmt.Printf("Bankroll %s is not valid\n", args[2])
+		os.Exit(1)
+	}
+
+	st := fetchStrategy(app, args[0])
+
+	plans := []strategy.StakingPlan{st.StakingPlan}
+
+	for _, a := range args[3:] {
+		parts := strings.Split(a, ":")
+		value, err := strconv.ParseFloat(parts[len(parts)-1], 32)
+
+		if len(parts) != 2 || err != nil {
+			fmt.Printf("Staking plan %s is not valid\n", a)
+			os.Exit(1)
+		}
+
+		plans = append(plans, strategy.StakingPlan{Name: parts[0], Number: float32(value)})
+	}
+
+	var trades []*strategy.Trade
+
+	for t := range app.StrategyBuilder().Build(context.Background(), strategy.NewBuilderQuery(st, args[1])) {
+		trades = append(trades, t)
+	}
+
+	simulations := []*strategy.BankrollSimulation{}
+
+	for _, p := range plans {
+		sim, err := strategy.SimulateBankroll(trades, p, float32(bankroll), app.StrategyCommission(), st.UserID.String())
+
+		if err != nil {
+			fmt.Printf("Error simulating staking plan %s: %s\n", p.Name, err.Error())
+			os.Exit(1)
+		}
+
+		simulations = append(simulations, sim)
+	}
+
+	out, err := json.MarshalIndent(simulations, "", "  ")
+
+	if err != nil {
+		app.Logger.Errorf("error encoding bankroll simulations: %+v", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(out))
+}
+
+// fetchStrategy returns the saved strategy with the ID provided, exiting if it does not exist.
+func fetchStrategy(app bootstrap.Container, strategyID string) *strategy.Strategy {
+	id, err := uuid.Parse(strategyID)
+
+	if err != nil {
+		fmt.Printf("Strategy ID %s is not valid\n", strategyID)
+		os.Exit(1)
+	}
+
+	st, err := app.StrategyReader().Get(&strategy.ReaderQuery{ID: &id})
+
+	if err != nil {
+		app.Logger.Errorf("error fetching strategy %s: %+v", id, err)
+		os.Exit(1)
+	}
+
+	if len(st) == 0 {
+		fmt.Printf("Strategy %s does not exist\n", id)
+		os.Exit(1)
+	}
+
+	return st[0]
+}
+
+// settleTrades settles IN_PLAY trades for events that kicked off more than three hours ago.
+func settleTrades(app bootstrap.Container) {
+	ctx := context.Background()
+	before := app.Clock.Now().Add(-3 * time.Hour)
+
+	trades, err := app.TradeReader().Get(&trade.ReaderQuery{
+		Result:          []string{trade.InPlay},
+		EventDateBefore: &before,
+	})
+
+	if err != nil {
+		app.Logger.Errorf("error fetching in play trades: %+v", err)
+		os.Exit(1)
+	}
+
+	settler := app.TradeSettler()
+	commission := app.StrategyCommission()
+
+	for _, t := range trades {
+		if err := settler.Settle(ctx, t); err != nil {
+			app.Logger.Errorf("error settling trade %s: %+v", t.ID, err)
+			continue
+		}
+
+		profit, err := t.NetProfit(commission.Rate(t.Exchange, app.Config.User.ID))
+
+		if err != nil {
+			app.Logger.Errorf("error calculating profit for trade %s: %+v", t.ID, err)
+			continue
+		}
+
+		fmt.Printf("Settled trade %s with result %s and net profit %.2f\n", t.ID, t.Result, profit)
+	}
+}
+
+// updateFixtureStatus records a postponed, abandoned or awarded fixture. Awarded fixtures require the official
+// score, for example "fixture:status 18273 AWARDED 3 0".
+func updateFixtureStatus(app bootstrap.Container, args []string) {
+	if len(args) != 2 && len(args) != 4 {
+		fmt.Println("Usage: fixture:status <fixture-id> <status> [<home-score> <away-score>]")
+		os.Exit(1)
+	}
+
+	fixtureID, err := strconv.ParseUint(args[0], 10, 64)
+
+	if err != nil {
+		fmt.Printf("Fixture ID %s is not valid\n", args[0])
+		os.Exit(1)
+	}
+
+	if args[1] != strategy.Postponed && args[1] != strategy.Abandoned && args[1] != strategy.Awarded {
+		fmt.Printf("Status %s is not supported\n", args[1])
+		os.Exit(1)
+	}
+
+	if args[1] == strategy.Awarded && len(args) != 4 {
+		fmt.Println("Awarded fixtures require the official score")
+		os.Exit(1)
+	}
+
+	status := strategy.FixtureStatus{
+		FixtureID: fixtureID,
+		Status:    args[1],
+		UpdatedAt: app.Clock.Now(),
+	}
+
+	if len(args) == 4 {
+		home, homeErr := strconv.ParseUint(args[2], 10, 32)
+		away, awayErr := strconv.ParseUint(args[3], 10, 32)
+
+		if homeErr != nil || awayErr != nil {
+			fmt.Printf("Score %s - %s is not valid\n", args[2], args[3])
+			os.Exit(1)
+		}
+
+		h, a := uint32(home), uint32(away)
+		status.HomeScore = &h
+		status.AwayScore = &a
+	}
+
+	if err := app.StrategyFixtureStatusWriter().Upsert(&status); err != nil {
+		app.Logger.Errorf("error saving fixture status for fixture %d: %+v", fixtureID, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Fixture %d marked as %s\n", fixtureID, status.Status)
 }
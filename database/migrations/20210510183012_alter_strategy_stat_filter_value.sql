@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE strategy_stat_filter ALTER COLUMN value TYPE FLOAT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strategy_stat_filter ALTER COLUMN value TYPE SMALLINT;
+-- +goose StatementEnd
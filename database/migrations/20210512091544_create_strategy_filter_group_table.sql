@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE strategy_filter_group (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    strategy_id VARCHAR NOT NULL,
+    parent_id VARCHAR,
+    operator VARCHAR NOT NULL,
+    position SMALLINT NOT NULL,
+    CONSTRAINT fk_strategy
+        FOREIGN KEY(strategy_id)
+            REFERENCES strategy(id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_parent
+        FOREIGN KEY(parent_id)
+            REFERENCES strategy_filter_group(id)
+            ON DELETE CASCADE
+);
+
+ALTER TABLE strategy_result_filter
+    ADD COLUMN group_id VARCHAR
+        REFERENCES strategy_filter_group(id)
+        ON DELETE CASCADE;
+
+ALTER TABLE strategy_stat_filter
+    ADD COLUMN group_id VARCHAR
+        REFERENCES strategy_filter_group(id)
+        ON DELETE CASCADE;
+
+CREATE INDEX ON strategy_filter_group (strategy_id);
+CREATE INDEX ON strategy_result_filter (group_id);
+CREATE INDEX ON strategy_stat_filter (group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strategy_result_filter DROP COLUMN group_id;
+ALTER TABLE strategy_stat_filter DROP COLUMN group_id;
+DROP TABLE strategy_filter_group;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE strategy_league_table_filter (
+    strategy_id VARCHAR NOT NULL,
+    group_id VARCHAR,
+    team VARCHAR NOT NULL,
+    measure VARCHAR NOT NULL,
+    metric VARCHAR NOT NULL,
+    value SMALLINT NOT NULL,
+    CONSTRAINT fk_strategy
+        FOREIGN KEY(strategy_id)
+            REFERENCES strategy(id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_group
+        FOREIGN KEY(group_id)
+            REFERENCES strategy_filter_group(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX ON strategy_league_table_filter (strategy_id);
+CREATE INDEX ON strategy_league_table_filter (group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE strategy_league_table_filter;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE strategy_result_filter
+    ADD COLUMN lookback VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN min_games SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE strategy_stat_filter
+    ADD COLUMN lookback VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN min_games SMALLINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strategy_result_filter DROP COLUMN lookback, DROP COLUMN min_games;
+ALTER TABLE strategy_stat_filter DROP COLUMN lookback, DROP COLUMN min_games;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE strategy_stat_filter
+    ADD COLUMN threshold FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN decay FLOAT NOT NULL DEFAULT 0;
+
+ALTER TABLE strategy_head_to_head_filter
+    ADD COLUMN threshold FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN decay FLOAT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strategy_stat_filter DROP COLUMN threshold, DROP COLUMN decay;
+ALTER TABLE strategy_head_to_head_filter DROP COLUMN threshold, DROP COLUMN decay;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE strategy_comparative_stat_filter (
+    strategy_id VARCHAR NOT NULL,
+    group_id VARCHAR,
+    stat VARCHAR NOT NULL,
+    home_action VARCHAR NOT NULL,
+    home_venue VARCHAR NOT NULL,
+    away_action VARCHAR NOT NULL,
+    away_venue VARCHAR NOT NULL,
+    games SMALLINT NOT NULL,
+    lookback VARCHAR NOT NULL,
+    min_games SMALLINT NOT NULL,
+    measure VARCHAR NOT NULL,
+    threshold FLOAT NOT NULL,
+    decay FLOAT NOT NULL,
+    comparison VARCHAR NOT NULL,
+    metric VARCHAR NOT NULL,
+    value FLOAT NOT NULL,
+    CONSTRAINT fk_strategy
+        FOREIGN KEY(strategy_id)
+            REFERENCES strategy(id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_group
+        FOREIGN KEY(group_id)
+            REFERENCES strategy_filter_group(id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX ON strategy_comparative_stat_filter (strategy_id);
+CREATE INDEX ON strategy_comparative_stat_filter (group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE strategy_comparative_stat_filter;
+-- +goose StatementEnd
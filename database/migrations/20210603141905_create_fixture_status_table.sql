@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE fixture_status (
+    fixture_id INTEGER NOT NULL PRIMARY KEY,
+    status VARCHAR NOT NULL,
+    home_score INTEGER,
+    away_score INTEGER,
+    updated_at INTEGER NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE fixture_status;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE backtest_run (
+    id VARCHAR NOT NULL PRIMARY KEY,
+    user_id VARCHAR NOT NULL,
+    query JSON NOT NULL,
+    summary JSON NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX on backtest_run (user_id);
+
+CREATE TABLE backtest_run_trade (
+    run_id VARCHAR NOT NULL,
+    market VARCHAR NOT NULL,
+    runner VARCHAR NOT NULL,
+    event_id INTEGER NOT NULL,
+    competition_id INTEGER NOT NULL,
+    season_id INTEGER NOT NULL,
+    event_date INTEGER NOT NULL,
+    exchange VARCHAR NOT NULL,
+    price FLOAT NOT NULL,
+    side VARCHAR NOT NULL,
+    result VARCHAR NOT NULL
+);
+
+CREATE INDEX on backtest_run_trade (run_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE backtest_run_trade;
+DROP TABLE backtest_run;
+-- +goose StatementEnd
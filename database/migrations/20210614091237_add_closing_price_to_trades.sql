@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trade ADD COLUMN closing_price FLOAT;
+ALTER TABLE backtest_run_trade ADD COLUMN closing_price FLOAT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE trade DROP COLUMN closing_price;
+ALTER TABLE backtest_run_trade DROP COLUMN closing_price;
+-- +goose StatementEnd
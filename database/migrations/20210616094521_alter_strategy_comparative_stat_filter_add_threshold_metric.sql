@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE strategy_comparative_stat_filter ADD COLUMN threshold_metric VARCHAR NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strategy_comparative_stat_filter DROP COLUMN threshold_metric;
+-- +goose StatementEnd
@@ -1,12 +1,17 @@
 package bootstrap
 
 import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 )
 
 type Config struct {
 	AWS
+	Backtest
+	Commission strategy.Commission
 	Database
 	HTTPClient  *http.Client
 	QueueDriver string
@@ -24,6 +29,12 @@ type AWS struct {
 	Secret   string
 }
 
+// Backtest contains the number of settled trades below which backtest results are flagged as an insufficient
+// sample.
+type Backtest struct {
+	MinimumSample int
+}
+
 type Database struct {
 	Driver   string
 	Host     string
@@ -67,6 +78,17 @@ func BuildConfig() *Config {
 		Secret:            os.Getenv("AWS_SECRET"),
 	}
 
+	config.Backtest = Backtest{MinimumSample: strategy.DefaultMinimumSample}
+
+	if min, err := strconv.Atoi(os.Getenv("BACKTEST_MINIMUM_SAMPLE")); err == nil {
+		config.Backtest.MinimumSample = min
+	}
+
+	config.Commission = strategy.Commission{
+		Rates:     parsePercentages(os.Getenv("EXCHANGE_COMMISSION_RATES")),
+		Discounts: parsePercentages(os.Getenv("USER_COMMISSION_DISCOUNTS")),
+	}
+
 	config.Database = Database{
 		Driver:   os.Getenv("DB_DRIVER"),
 		Host:     os.Getenv("DB_HOST"),
@@ -102,3 +124,27 @@ func BuildConfig() *Config {
 
 	return &config
 }
+
+// parsePercentages parses a comma separated list of key and percentage pairs, for example "betfair:5,smarkets:2".
+// Pairs that cannot be parsed are ignored.
+func parsePercentages(value string) map[string]float32 {
+	percentages := map[string]float32{}
+
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+
+		if len(parts) != 2 {
+			continue
+		}
+
+		p, err := strconv.ParseFloat(parts[1], 32)
+
+		if err != nil {
+			continue
+		}
+
+		percentages[parts[0]] = float32(p)
+	}
+
+	return percentages
+}
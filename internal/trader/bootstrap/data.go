@@ -9,3 +9,11 @@ func (c Container) DataServiceResultClient() statisticodata.ResultClient {
 func (c Container) DataServiceFixtureClient() statisticodata.FixtureClient {
 	return statisticodata.NewFixtureClient(c.GrpcFixtureClient())
 }
+
+func (c Container) DataServiceTeamClient() statisticodata.TeamClient {
+	return statisticodata.NewTeamClient(c.GrpcTeamClient())
+}
+
+func (c Container) DataServiceSeasonClient() statisticodata.SeasonClient {
+	return statisticodata.NewSeasonClient(c.GrpcSeasonClient())
+}
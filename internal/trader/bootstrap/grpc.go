@@ -7,6 +7,9 @@ func (c Container) GrpcStrategyService() *grpc.StrategyService {
 		c.StrategyBuilder(),
 		c.StrategyWriter(),
 		c.StrategyReader(),
+		c.StrategyCommission(),
+		c.Config.Backtest.MinimumSample,
+		c.StrategyBacktestWriter(),
 		c.Logger,
 		c.Clock,
 	)
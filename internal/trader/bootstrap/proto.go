@@ -33,6 +33,34 @@ func (c Container) GrpcResultClient() statistico.ResultServiceClient {
 	return statistico.NewResultServiceClient(conn)
 }
 
+func (c Container) GrpcSeasonClient() statistico.SeasonServiceClient {
+	config := c.Config
+
+	address := config.StatisticoDataService.Host + ":" + config.StatisticoDataService.Port
+
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+
+	if err != nil {
+		c.Logger.Warnf("Error initializing statistico data service grpc client %s", err.Error())
+	}
+
+	return statistico.NewSeasonServiceClient(conn)
+}
+
+func (c Container) GrpcTeamClient() statistico.TeamServiceClient {
+	config := c.Config
+
+	address := config.StatisticoDataService.Host + ":" + config.StatisticoDataService.Port
+
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+
+	if err != nil {
+		c.Logger.Warnf("Error initializing statistico data service grpc client %s", err.Error())
+	}
+
+	return statistico.NewTeamServiceClient(conn)
+}
+
 func (c Container) GrpcMarketClient() statistico.OddsWarehouseServiceClient {
 	config := c.Config
 
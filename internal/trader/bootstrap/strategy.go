@@ -12,20 +12,52 @@ func (c Container) StrategyReader() strategy.Reader {
 	return strategy.NewPostgresReader(c.Database)
 }
 
+func (c Container) StrategyBacktestWriter() strategy.BacktestWriter {
+	return strategy.NewPostgresBacktestWriter(c.Database)
+}
+
+func (c Container) StrategyBacktestReader() strategy.BacktestReader {
+	return strategy.NewPostgresBacktestReader(c.Database)
+}
+
+func (c Container) StrategyClosingPriceFinder() strategy.ClosingPriceFinder {
+	return strategy.NewClosingPriceFinder(c.OddsWarehouseMarketClient())
+}
+
 func (c Container) StrategyFilterMatcher() strategy.FilterMatcher {
 	return strategy.NewFilterMatcher(
 		c.DataServiceFixtureClient(),
 		c.StrategyResultClassifier(),
 		c.StrategyStatClassifier(),
+		c.StrategyHeadToHeadClassifier(),
+		c.StrategyLeagueTableClassifier(),
+		c.StrategyComparativeStatClassifier(),
+		c.StrategyScheduleClassifier(),
 	)
 }
 
 func (c Container) StrategyResultClassifier() strategy.ResultFilterClassifier {
-	return strategy.NewResultFilterClassifier(c.DataServiceResultClient())
+	return strategy.NewResultFilterClassifier(c.DataServiceResultClient(), c.DataServiceSeasonClient())
 }
 
 func (c Container) StrategyStatClassifier() strategy.StatFilterClassifier {
-	return strategy.NewStatFilterClassifier(c.DataServiceResultClient())
+	return strategy.NewStatFilterClassifier(c.DataServiceResultClient(), c.DataServiceSeasonClient())
+}
+
+func (c Container) StrategyHeadToHeadClassifier() strategy.HeadToHeadFilterClassifier {
+	return strategy.NewHeadToHeadFilterClassifier(c.DataServiceResultClient())
+}
+
+func (c Container) StrategyLeagueTableClassifier() strategy.LeagueTableFilterClassifier {
+	return strategy.NewLeagueTableFilterClassifier(c.DataServiceTeamClient(), c.DataServiceResultClient(), c.Clock)
+}
+
+func (c Container) StrategyComparativeStatClassifier() strategy.ComparativeStatFilterClassifier {
+	return strategy.NewComparativeStatFilterClassifier(c.DataServiceResultClient(), c.DataServiceSeasonClient())
+}
+
+func (c Container) StrategyScheduleClassifier() strategy.ScheduleFilterClassifier {
+	return strategy.NewScheduleFilterClassifier(c.DataServiceResultClient())
 }
 
 func (c Container) StrategyFinder() strategy.Finder {
@@ -33,14 +65,60 @@ func (c Container) StrategyFinder() strategy.Finder {
 }
 
 func (c Container) StrategyResultParser() strategy.ResultParser {
-	return strategy.NewResultParser(c.DataServiceResultClient())
+	return strategy.NewResultParser(c.DataServiceResultClient(), c.StrategyFixtureStatusReader())
+}
+
+func (c Container) StrategySettlementResultParser() strategy.ResultParser {
+	return strategy.NewSettlementResultParser(c.DataServiceResultClient(), c.StrategyFixtureStatusReader())
+}
+
+func (c Container) StrategyFixtureStatusReader() strategy.FixtureStatusReader {
+	return strategy.NewPostgresFixtureStatusReader(c.Database)
+}
+
+func (c Container) StrategyFixtureStatusWriter() strategy.FixtureStatusWriter {
+	return strategy.NewPostgresFixtureStatusWriter(c.Database)
 }
 
 func (c Container) StrategyBuilder() strategy.Builder {
 	return strategy.NewBuilder(
 		c.StrategyFilterMatcher(),
 		c.StrategyResultParser(),
+		strategy.NewCachedClosingPriceFinder(c.OddsWarehouseMarketClient()),
 		c.OddsWarehouseMarketClient(),
 		c.Logger,
 	)
 }
+
+func (c Container) StrategyCommission() strategy.Commission {
+	return c.Config.Commission
+}
+
+// StrategyOptimiser uses data service clients that cache lookups so each combination evaluated reuses the
+// fixtures, results, seasons and teams fetched for previous combinations.
+func (c Container) StrategyOptimiser() strategy.Optimiser {
+	fixtureClient := strategy.NewCachedFixtureClient(c.DataServiceFixtureClient())
+	resultClient := strategy.NewCachedResultClient(c.DataServiceResultClient())
+	seasonClient := strategy.NewCachedSeasonClient(c.DataServiceSeasonClient())
+	teamClient := strategy.NewCachedTeamClient(c.DataServiceTeamClient())
+
+	matcher := strategy.NewFilterMatcher(
+		fixtureClient,
+		strategy.NewResultFilterClassifier(resultClient, seasonClient),
+		strategy.NewStatFilterClassifier(resultClient, seasonClient),
+		strategy.NewHeadToHeadFilterClassifier(resultClient),
+		strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, c.Clock),
+		strategy.NewComparativeStatFilterClassifier(resultClient, seasonClient),
+		strategy.NewScheduleFilterClassifier(resultClient),
+	)
+
+	builder := strategy.NewBuilder(
+		matcher,
+		strategy.NewResultParser(resultClient, c.StrategyFixtureStatusReader()),
+		strategy.NewCachedClosingPriceFinder(c.OddsWarehouseMarketClient()),
+		c.OddsWarehouseMarketClient(),
+		c.Logger,
+	)
+
+	return strategy.NewOptimiser(builder, c.Config.Backtest.MinimumSample)
+}
@@ -19,3 +19,7 @@ func (c Container) TradePlacer() trade.Placer {
 func (c Container) TradeManager() trade.Manager {
 	return trade.NewManager(c.ExchangeClientFactory(), c.UserService(), c.TradePlacer())
 }
+
+func (c Container) TradeSettler() trade.Settler {
+	return trade.NewSettler(c.StrategySettlementResultParser(), c.StrategyClosingPriceFinder(), c.TradeWriter(), c.Logger)
+}
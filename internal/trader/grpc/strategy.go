@@ -2,21 +2,29 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
 	"github.com/sirupsen/logrus"
 	"github.com/statistico/statistico-proto/go"
 	"github.com/statistico/statistico-trader/internal/trader/errors"
 	"github.com/statistico/statistico-trader/internal/trader/strategy"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"strconv"
 )
 
 type StrategyService struct {
-	builder    strategy.Builder
-	reader     strategy.Reader
-	writer     strategy.Writer
-	logger     *logrus.Logger
-	clock      clockwork.Clock
+	builder        strategy.Builder
+	reader         strategy.Reader
+	writer         strategy.Writer
+	commission     strategy.Commission
+	minSample      int
+	backtestWriter strategy.BacktestWriter
+	logger         *logrus.Logger
+	clock          clockwork.Clock
 	statistico.UnimplementedStrategyServiceServer
 }
 
@@ -44,17 +52,165 @@ func (s *StrategyService) BuildStrategy(r *statistico.BuildStrategyRequest, stre
 		query.MaxOdds = &r.GetMaxOdds().Value
 	}
 
+	split, err := requestSplit(stream.Context())
+
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ch := s.builder.Build(stream.Context(), &query)
 
+	voids := 0
+	skipped := map[strategy.Result]int{}
+	var trades []*strategy.Trade
+
 	for t := range ch {
+		trades = append(trades, t)
+
+		if t.Result == strategy.Void {
+			voids++
+			continue
+		}
+
+		if _, ok := statistico.TradeResultEnum_value[string(t.Result)]; !ok {
+			s.logger.Infof("skipping %s trade for market %s and event %d", t.Result, t.MarketName, t.EventID)
+			skipped[t.Result]++
+			continue
+		}
+
 		if err := stream.Send(transformStrategyTrade(t)); err != nil {
 			s.logger.Errorf("error streaming strategy trade back to client: %s", err.Error())
 		}
 	}
 
+	// TradeResultEnum cannot represent VOID trades so the number of void trades is reported in the trailer
+	trailer := metadata.Pairs("void-trades", strconv.Itoa(voids))
+
+	// Trades with other results TradeResultEnum cannot represent, such as HALF_WIN, are counted by result
+	if b, err := json.Marshal(skipped); err == nil {
+		trailer.Set("skipped-trades", string(b))
+	} else {
+		s.logger.Errorf("error marshalling skipped trades: %s", err.Error())
+	}
+
+	userID := fmt.Sprintf("%v", stream.Context().Value("userID"))
+
+	summary, err := s.summary(trades, userID)
+
+	if err != nil {
+		s.logger.Errorf("error calculating strategy summary: %s", err.Error())
+	}
+
+	if summary != nil {
+		if b, err := json.Marshal(summary); err == nil {
+			trailer.Set("summary", string(b))
+		} else {
+			s.logger.Errorf("error marshalling strategy summary: %s", err.Error())
+		}
+
+		// Runs can only be stored against a user so anonymous requests are not persisted
+		if uid, err := uuid.Parse(userID); err == nil {
+			if id, err := s.saveBacktestRun(&query, summary, trades, uid); err == nil {
+				trailer.Set("run-id", id.String())
+			} else {
+				s.logger.Errorf("error saving backtest run: %s", err.Error())
+			}
+		}
+	}
+
+	if split != nil {
+		if sp, err := s.split(trades, split, userID); err == nil {
+			trailer.Set("split", sp)
+		} else {
+			s.logger.Errorf("error calculating strategy split performance: %s", err.Error())
+		}
+	}
+
+	stream.SetTrailer(trailer)
+
 	return nil
 }
 
+// summary returns the *strategy.Summary, including its strategy.Significance, for the trades built by
+// BuildStrategy net of the commission charged to the user.
+func (s *StrategyService) summary(trades []*strategy.Trade, userID string) (*strategy.Summary, error) {
+	sm, err := strategy.NewSummary(trades, s.commission, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sm.Significance, err = strategy.NewSignificance(trades, s.commission, userID, s.minSample)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sm, nil
+}
+
+// saveBacktestRun stores the query, trades and summary so the run can be revisited without rebuilding the trades.
+func (s *StrategyService) saveBacktestRun(
+	q *strategy.BuilderQuery,
+	sm *strategy.Summary,
+	trades []*strategy.Trade,
+	userID uuid.UUID,
+) (uuid.UUID, error) {
+	run := strategy.BacktestRun{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Query:     q,
+		Summary:   sm,
+		Trades:    trades,
+		CreatedAt: s.clock.Now(),
+	}
+
+	if err := s.backtestWriter.Insert(&run); err != nil {
+		return uuid.Nil, err
+	}
+
+	return run.ID, nil
+}
+
+// split returns the JSON encoded in-sample and out-of-sample performance for the trades built by BuildStrategy.
+func (s *StrategyService) split(trades []*strategy.Trade, split *strategy.Split, userID string) (string, error) {
+	sp, err := strategy.NewSplitPerformance(trades, *split, s.commission, userID)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(sp)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// requestSplit returns the strategy.Split provided as JSON in the "split" request metadata, which BuildStrategyRequest
+// cannot carry, or nil if a split was not requested.
+func requestSplit(ctx context.Context) (*strategy.Split, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok || len(md.Get("split")) == 0 {
+		return nil, nil
+	}
+
+	var split strategy.Split
+
+	if err := json.Unmarshal([]byte(md.Get("split")[0]), &split); err != nil {
+		return nil, fmt.Errorf("split metadata is not valid: %s", err.Error())
+	}
+
+	if err := split.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
+
 func (s *StrategyService) SaveStrategy(ctx context.Context, r *statistico.SaveStrategyRequest) (*statistico.Strategy, error) {
 	st, err := strategyFromRequest(ctx, r, s.clock.Now())
 
@@ -105,14 +261,20 @@ func NewStrategyService(
 	b strategy.Builder,
 	w strategy.Writer,
 	r strategy.Reader,
+	c strategy.Commission,
+	min int,
+	bw strategy.BacktestWriter,
 	l *logrus.Logger,
 	cl clockwork.Clock,
 ) *StrategyService {
 	return &StrategyService{
-		builder:    b,
-		writer:     w,
-		reader:     r,
-		logger:     l,
-		clock:      cl,
+		builder:        b,
+		writer:         w,
+		reader:         r,
+		commission:     c,
+		minSample:      min,
+		backtestWriter: bw,
+		logger:         l,
+		clock:          cl,
 	}
 }
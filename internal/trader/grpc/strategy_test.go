@@ -2,6 +2,7 @@ package grpc_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -122,7 +124,7 @@ func TestStrategyService_BuildStrategy(t *testing.T) {
 
 		stream := new(MockStrategyBuildServer)
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		ctx := context.Background()
 
@@ -133,6 +135,7 @@ func TestStrategyService_BuildStrategy(t *testing.T) {
 		builder.On("Build", ctx, query).Return(tradeCh)
 
 		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("0")).Once()
 
 		err := service.BuildStrategy(&req, stream)
 
@@ -156,7 +159,7 @@ func TestStrategyService_BuildStrategy(t *testing.T) {
 
 		stream := new(MockStrategyBuildServer)
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		ctx := context.Background()
 
@@ -167,6 +170,7 @@ func TestStrategyService_BuildStrategy(t *testing.T) {
 		builder.On("Build", ctx, query).Return(tradeCh)
 
 		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(errors.New("stream error"))
+		stream.On("SetTrailer", trailerWithVoids("0")).Once()
 
 		err := service.BuildStrategy(&req, stream)
 
@@ -179,6 +183,354 @@ func TestStrategyService_BuildStrategy(t *testing.T) {
 		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
 		stream.AssertExpectations(t)
 	})
+	t.Run("logs and skips trades with results that cannot be streamed", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
+
+		ctx := context.Background()
+
+		stream.On("Context").Return(ctx)
+
+		halfWin := &strategy.Trade{
+			MarketName: "ASIAN_HANDICAP",
+			RunnerName: "Home -0.25",
+			Price:      1.90,
+			EventID:    138172,
+			Side:       "BACK",
+			Result:     strategy.Result("HALF_WIN"),
+		}
+
+		halfLose := &strategy.Trade{
+			MarketName: "ASIAN_HANDICAP",
+			RunnerName: "Home -0.25",
+			Price:      1.95,
+			EventID:    138173,
+			Side:       "BACK",
+			Result:     strategy.Result("HALF_LOSE"),
+		}
+
+		tradeCh := tradeChannel(append([]*strategy.Trade{halfWin, halfLose}, trades...))
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		skipped := mock.MatchedBy(func(md metadata.MD) bool {
+			v := md.Get("skipped-trades")
+			return len(v) == 1 && v[0] == `{"HALF_LOSE":1,"HALF_WIN":1}`
+		})
+
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", skipped).Once()
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(hook.Entries))
+		assert.Equal(t, "skipping HALF_WIN trade for market ASIAN_HANDICAP and event 138172", hook.Entries[0].Message)
+		assert.Equal(t, "skipping HALF_LOSE trade for market ASIAN_HANDICAP and event 138173", hook.LastEntry().Message)
+		assert.Equal(t, logrus.InfoLevel, hook.LastEntry().Level)
+		stream.AssertExpectations(t)
+	})
+	t.Run("reports void trades in the stream trailer", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
+
+		ctx := context.Background()
+
+		stream.On("Context").Return(ctx)
+
+		void := &strategy.Trade{
+			MarketName: "DRAW_NO_BET",
+			RunnerName: "Home",
+			EventID:    138172,
+			Side:       "BACK",
+			Result:     strategy.Result("VOID"),
+		}
+
+		tradeCh := tradeChannel([]*strategy.Trade{void, void, trades[0]})
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("2")).Once()
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(hook.Entries))
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("reports the backtest summary in the stream trailer", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
+
+		ctx := context.Background()
+
+		stream.On("Context").Return(ctx)
+
+		fail := &strategy.Trade{
+			MarketName:    "BOTH_TEAMS_TO_SCORE",
+			RunnerName:    "Yes",
+			Price:         2.50,
+			EventID:       138172,
+			CompetitionID: 8,
+			SeasonID:      17420,
+			Side:          "BACK",
+			EventDate:     time.Unix(1584100800, 0),
+			Result:        strategy.Result("FAIL"),
+		}
+
+		tradeCh := tradeChannel([]*strategy.Trade{fail, trades[0]})
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		var md metadata.MD
+
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Twice().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("0")).Once().Run(func(args mock.Arguments) {
+			md = args.Get(0).(metadata.MD)
+		})
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		var summary strategy.Summary
+
+		if err := json.Unmarshal([]byte(md.Get("summary")[0]), &summary); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, summary.Trades)
+		assert.Equal(t, 1, summary.Wins)
+		assert.Equal(t, 1, summary.Losses)
+		assert.InDelta(t, -0.05, summary.Profit, 0.0001)
+		assert.InDelta(t, 1.0, summary.MaxDrawdown, 0.0001)
+		assert.Equal(t, 2, summary.Competitions[8].Trades)
+		assert.Equal(t, 2, summary.Seasons[17420].Trades)
+		assert.Equal(t, 2, summary.Significance.SampleSize)
+		assert.Equal(t, 100, summary.Significance.MinimumSample)
+		assert.True(t, summary.Significance.InsufficientSample)
+		assert.Equal(t, 0, len(hook.Entries))
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("reports in-sample and out-of-sample performance if a split is requested", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
+
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("split", `{"cutOff": "2020-03-12T00:00:00Z"}`),
+		)
+
+		stream.On("Context").Return(ctx)
+
+		tradeCh := tradeChannel(trades)
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		var md metadata.MD
+
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("0")).Once().Run(func(args mock.Arguments) {
+			md = args.Get(0).(metadata.MD)
+		})
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		var splits []*strategy.SplitPerformance
+
+		if err := json.Unmarshal([]byte(md.Get("split")[0]), &splits); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(splits))
+		assert.Equal(t, 0, splits[0].InSample.Trades)
+		assert.Equal(t, 1, splits[0].OutOfSample.Trades)
+		assert.Equal(t, 0, len(hook.Entries))
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("returns invalid argument error if split metadata is not valid", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		logger, _ := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
+
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("split", `{"inSampleDays": 365}`),
+		)
+
+		stream.On("Context").Return(ctx)
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rpc error: code = InvalidArgument desc = walk-forward splits require in-sample and out-of-sample days", err.Error())
+		builder.AssertNotCalled(t, "Build", ctx, query)
+		stream.AssertNotCalled(t, "SetTrailer", mock.Anything)
+	})
+
+	t.Run("saves the backtest run for the user and reports the run ID in the stream trailer", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		backtestWriter := new(MockBacktestWriter)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, backtestWriter, logger, clock)
+
+		ctx := context.WithValue(context.Background(), "userID", "a5f04fd2-dfe7-41c1-af38-d490119705d8")
+
+		stream.On("Context").Return(ctx)
+
+		tradeCh := tradeChannel(trades)
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		run := mock.MatchedBy(func(r *strategy.BacktestRun) bool {
+			a := assert.New(t)
+
+			a.NotEqual(uuid.Nil, r.ID)
+			a.Equal("a5f04fd2-dfe7-41c1-af38-d490119705d8", r.UserID.String())
+			a.Equal("BOTH_TEAMS_TO_SCORE", r.Query.Market)
+			a.Equal(trades, r.Trades)
+			a.Equal(1, r.Summary.Trades)
+			a.NotNil(r.Summary.Significance)
+			a.Equal(time.Unix(1616936636, 0), r.CreatedAt)
+			return true
+		})
+
+		var md metadata.MD
+
+		backtestWriter.On("Insert", run).Once().Return(nil)
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("0")).Once().Run(func(args mock.Arguments) {
+			md = args.Get(0).(metadata.MD)
+		})
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		id := backtestWriter.Calls[0].Arguments.Get(0).(*strategy.BacktestRun).ID
+
+		assert.Equal(t, []string{id.String()}, md.Get("run-id"))
+		assert.Equal(t, 0, len(hook.Entries))
+		backtestWriter.AssertExpectations(t)
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("logs error and omits run ID from the stream trailer if the backtest run cannot be saved", func(t *testing.T) {
+		t.Helper()
+
+		writer := new(MockStrategyWriter)
+		reader := new(MockStrategyReader)
+		builder := new(MockStrategyBuilder)
+		backtestWriter := new(MockBacktestWriter)
+		logger, hook := test.NewNullLogger()
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+		stream := new(MockStrategyBuildServer)
+
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, backtestWriter, logger, clock)
+
+		ctx := context.WithValue(context.Background(), "userID", "a5f04fd2-dfe7-41c1-af38-d490119705d8")
+
+		stream.On("Context").Return(ctx)
+
+		tradeCh := tradeChannel(trades)
+
+		builder.On("Build", ctx, query).Return(tradeCh)
+
+		var md metadata.MD
+
+		backtestWriter.On("Insert", mock.AnythingOfType("*strategy.BacktestRun")).Once().Return(errors.New("database error"))
+		stream.On("Send", mock.AnythingOfType("*statistico.StrategyTrade")).Once().Return(nil)
+		stream.On("SetTrailer", trailerWithVoids("0")).Once().Run(func(args mock.Arguments) {
+			md = args.Get(0).(metadata.MD)
+		})
+
+		err := service.BuildStrategy(&req, stream)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, len(md.Get("run-id")))
+		assert.Equal(t, 1, len(hook.Entries))
+		assert.Equal(t, "error saving backtest run: database error", hook.LastEntry().Message)
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		backtestWriter.AssertExpectations(t)
+		stream.AssertExpectations(t)
+	})
 }
 
 func TestStrategyService_SaveStrategy(t *testing.T) {
@@ -191,7 +543,7 @@ func TestStrategyService_SaveStrategy(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		r := &statistico.SaveStrategyRequest{
 			Name:           "Money Maker v1",
@@ -311,7 +663,7 @@ func TestStrategyService_SaveStrategy(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		r := &statistico.SaveStrategyRequest{
 			Name:           "Money Maker v1",
@@ -375,7 +727,7 @@ func TestStrategyService_SaveStrategy(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		r := &statistico.SaveStrategyRequest{
 			Name:           "Money Maker v1",
@@ -483,7 +835,7 @@ func TestStrategyService_SaveStrategy(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		r := &statistico.SaveStrategyRequest{
 			Name:           "Money Maker v1",
@@ -593,7 +945,7 @@ func TestStrategyService_ListUserStrategies(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
 
-		service := g.NewStrategyService(builder, writer, reader, logger, clock)
+		service := g.NewStrategyService(builder, writer, reader, strategy.Commission{}, 100, new(MockBacktestWriter), logger, clock)
 
 		stream := new(MockStrategyServer)
 
@@ -674,6 +1026,15 @@ func (m *MockStrategyReader) Get(q *strategy.ReaderQuery) ([]*strategy.Strategy,
 	return args.Get(0).([]*strategy.Strategy), args.Error(1)
 }
 
+type MockBacktestWriter struct {
+	mock.Mock
+}
+
+func (m *MockBacktestWriter) Insert(r *strategy.BacktestRun) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
 type MockStrategyBuildServer struct {
 	mock.Mock
 	grpc.ServerStream
@@ -689,6 +1050,10 @@ func (m *MockStrategyBuildServer) Context() context.Context {
 	return args.Get(0).(context.Context)
 }
 
+func (m *MockStrategyBuildServer) SetTrailer(md metadata.MD) {
+	m.Called(md)
+}
+
 type MockStrategyServer struct {
 	mock.Mock
 	grpc.ServerStream
@@ -705,6 +1070,13 @@ func (m *MockStrategyServer) Context() context.Context {
 }
 
 
+func trailerWithVoids(voids string) interface{} {
+	return mock.MatchedBy(func(md metadata.MD) bool {
+		v := md.Get("void-trades")
+		return len(v) == 1 && v[0] == voids && len(md.Get("summary")) == 1
+	})
+}
+
 func tradeChannel(trades []*strategy.Trade) <-chan *strategy.Trade {
 	ch := make(chan *strategy.Trade, len(trades))
 
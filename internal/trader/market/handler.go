@@ -7,6 +7,7 @@ import (
 	"github.com/statistico/statistico-trader/internal/trader/strategy"
 	"github.com/statistico/statistico-trader/internal/trader/trade"
 	"sync"
+	"time"
 )
 
 type Handler interface {
@@ -56,7 +57,7 @@ func (h *handler) handleBackRunner(ctx context.Context, e *queue.EventMarket, r
 		},
 	}
 
-	h.handleRunner(ctx, &t, wg)
+	h.handleRunner(ctx, &t, time.Unix(e.Timestamp, 0), wg)
 
 	wg.Done()
 }
@@ -86,12 +87,12 @@ func (h *handler) handleLayRunner(ctx context.Context, e *queue.EventMarket, r *
 		},
 	}
 
-	h.handleRunner(ctx, &t, wg)
+	h.handleRunner(ctx, &t, time.Unix(e.Timestamp, 0), wg)
 
 	wg.Done()
 }
 
-func (h *handler) handleRunner(ctx context.Context, t *trade.Ticket, wg *sync.WaitGroup) {
+func (h *handler) handleRunner(ctx context.Context, t *trade.Ticket, timestamp time.Time, wg *sync.WaitGroup) {
 	query := strategy.FinderQuery{
 		MarketName:    t.MarketName,
 		RunnerName:    t.RunnerName,
@@ -100,6 +101,8 @@ func (h *handler) handleRunner(ctx context.Context, t *trade.Ticket, wg *sync.Wa
 		Price:         t.Price.Value,
 		Side:          t.Price.Side,
 		Status:        strategy.Active,
+		EventDate:     t.EventDate,
+		Timestamp:     timestamp,
 	}
 
 	st := h.finder.FindMatchingStrategies(ctx, &query)
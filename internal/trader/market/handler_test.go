@@ -72,7 +72,15 @@ func TestHandler_HandleEventMarket(t *testing.T) {
 
 		stOne := &strategy.Strategy{}
 
-		finder.On("FindMatchingStrategies", ctx, mock.AnythingOfType("*strategy.FinderQuery")).
+		finderQuery := mock.MatchedBy(func(q *strategy.FinderQuery) bool {
+			a := assert.New(t)
+			a.Equal(event.EventDate, q.EventDate)
+			a.Equal(time.Unix(1616936636, 0), q.Timestamp)
+			a.Equal(strategy.Active, q.Status)
+			return true
+		})
+
+		finder.On("FindMatchingStrategies", ctx, finderQuery).
 			Times(4).
 			Return(strategyChannel(stOne))
 
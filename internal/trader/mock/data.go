@@ -33,3 +33,31 @@ func (m *ResultClient) ByTeam(ctx context.Context, req *statistico.TeamResultReq
 	args := m.Called(ctx, req)
 	return args.Get(0).([]*statistico.Result), args.Error(1)
 }
+
+type SeasonClient struct {
+	mock.Mock
+}
+
+func (m *SeasonClient) ByTeamID(ctx context.Context, teamID uint64, sort string) ([]*statistico.Season, error) {
+	args := m.Called(ctx, teamID, sort)
+	return args.Get(0).([]*statistico.Season), args.Error(1)
+}
+
+func (m *SeasonClient) ByCompetitionID(ctx context.Context, competitionID uint64, sort string) ([]*statistico.Season, error) {
+	args := m.Called(ctx, competitionID, sort)
+	return args.Get(0).([]*statistico.Season), args.Error(1)
+}
+
+type TeamClient struct {
+	mock.Mock
+}
+
+func (m *TeamClient) ByID(ctx context.Context, teamID uint64) (*statistico.Team, error) {
+	args := m.Called(ctx, teamID)
+	return args.Get(0).(*statistico.Team), args.Error(1)
+}
+
+func (m *TeamClient) BySeasonID(ctx context.Context, seasonID uint64) ([]*statistico.Team, error) {
+	args := m.Called(ctx, seasonID)
+	return args.Get(0).([]*statistico.Team), args.Error(1)
+}
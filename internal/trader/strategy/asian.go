@@ -0,0 +1,102 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// getAsianHandicapResult settles runners in the format "Home -0.25", where the line is added to the goals scored
+// by the runner team.
+func getAsianHandicapResult(market, runner string, home, away uint32) (Result, error) {
+	team, line, err := parseRunnerLine(market, runner)
+
+	if err != nil {
+		return Fail, err
+	}
+
+	margin := float64(home) - float64(away)
+
+	switch team {
+	case Home:
+		return settleAsianLine(margin + line), nil
+	case Away:
+		return settleAsianLine(-margin + line), nil
+	default:
+		return Fail, returnRunnerError(market, runner)
+	}
+}
+
+// getAsianGoalLineResult settles runners in the format "Over 2.25" or "Over 2.25 Goals" against the total goals
+// scored in the match.
+func getAsianGoalLineResult(market, runner string, home, away uint32) (Result, error) {
+	side, line, err := parseRunnerLine(market, runner)
+
+	if err != nil {
+		return Fail, err
+	}
+
+	total := float64(home + away)
+
+	switch side {
+	case Over:
+		return settleAsianLine(total - line), nil
+	case Under:
+		return settleAsianLine(line - total), nil
+	default:
+		return Fail, returnRunnerError(market, runner)
+	}
+}
+
+// parseRunnerLine splits a runner name into its selection and line. Lines must be a multiple of 0.25.
+func parseRunnerLine(market, runner string) (string, float64, error) {
+	parts := strings.Fields(strings.TrimSuffix(runner, " Goals"))
+
+	if len(parts) != 2 {
+		return "", 0, returnRunnerError(market, runner)
+	}
+
+	line, err := strconv.ParseFloat(parts[1], 64)
+
+	if err != nil || math.Mod(line*4, 1) != 0 {
+		return "", 0, fmt.Errorf("unable to parse line from runner %s for market %s", runner, market)
+	}
+
+	return parts[0], line, nil
+}
+
+// settleAsianLine settles a handicapped margin. Quarter lines are split into two bets on the lines either side,
+// so a margin of 0.25 is half won and half void and returns HALF_WIN.
+func settleAsianLine(margin float64) Result {
+	outcome := 2 * lineOutcome(margin)
+
+	if math.Mod(margin*2, 1) != 0 {
+		outcome = lineOutcome(margin-0.25) + lineOutcome(margin+0.25)
+	}
+
+	switch outcome {
+	case 2:
+		return Success
+	case 1:
+		return HalfWin
+	case -1:
+		return HalfLose
+	case -2:
+		return Fail
+	default:
+		return Void
+	}
+}
+
+func lineOutcome(margin float64) int {
+	if margin > 0 {
+		return 1
+	}
+
+	if margin < 0 {
+		return -1
+	}
+
+	return 0
+}
@@ -0,0 +1,110 @@
+package strategy
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_getAsianHandicapResult(t *testing.T) {
+	t.Run("settles asian handicap runners using the line in the runner name", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Runner   string
+			Home     uint32
+			Away     uint32
+			Expected Result
+		}{
+			{Runner: "Home -0.5", Home: 1, Away: 0, Expected: Success},
+			{Runner: "Home -0.5", Home: 1, Away: 1, Expected: Fail},
+			{Runner: "Home 0", Home: 1, Away: 1, Expected: Void},
+			{Runner: "Home -1", Home: 2, Away: 1, Expected: Void},
+			{Runner: "Home -0.25", Home: 1, Away: 1, Expected: HalfLose},
+			{Runner: "Home -0.75", Home: 2, Away: 1, Expected: HalfWin},
+			{Runner: "Home -0.75", Home: 3, Away: 1, Expected: Success},
+			{Runner: "Away +0.25", Home: 1, Away: 1, Expected: HalfWin},
+			{Runner: "Away +0.75", Home: 1, Away: 0, Expected: HalfLose},
+			{Runner: "Away +1.5", Home: 2, Away: 0, Expected: Fail},
+			{Runner: "Away -1.25", Home: 0, Away: 1, Expected: HalfLose},
+		}
+
+		for i, c := range tc {
+			res, err := getAsianHandicapResult("ASIAN_HANDICAP", c.Runner, c.Home, c.Away)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if runner cannot be parsed", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Runner string
+			Error  string
+		}{
+			{Runner: "Home", Error: "runner Home not support for market ASIAN_HANDICAP"},
+			{Runner: "Draw -0.5", Error: "runner Draw -0.5 not support for market ASIAN_HANDICAP"},
+			{Runner: "Home -0.3", Error: "unable to parse line from runner Home -0.3 for market ASIAN_HANDICAP"},
+			{Runner: "Home abc", Error: "unable to parse line from runner Home abc for market ASIAN_HANDICAP"},
+		}
+
+		for _, c := range tc {
+			res, err := getAsianHandicapResult("ASIAN_HANDICAP", c.Runner, 1, 0)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, Result(Fail), res)
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
+
+func Test_getAsianGoalLineResult(t *testing.T) {
+	t.Run("settles asian goal line runners against total goals", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Runner   string
+			Home     uint32
+			Away     uint32
+			Expected Result
+		}{
+			{Runner: "Over 2.5", Home: 2, Away: 1, Expected: Success},
+			{Runner: "Over 2.5 Goals", Home: 1, Away: 1, Expected: Fail},
+			{Runner: "Over 2.25", Home: 1, Away: 1, Expected: HalfLose},
+			{Runner: "Over 2.75", Home: 2, Away: 1, Expected: HalfWin},
+			{Runner: "Over 3", Home: 2, Away: 1, Expected: Void},
+			{Runner: "Under 2.25", Home: 1, Away: 1, Expected: HalfWin},
+			{Runner: "Under 2.75", Home: 2, Away: 1, Expected: HalfLose},
+			{Runner: "Under 3.5", Home: 2, Away: 2, Expected: Fail},
+		}
+
+		for i, c := range tc {
+			res, err := getAsianGoalLineResult("ASIAN_GOAL_LINE", c.Runner, c.Home, c.Away)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if runner is not supported", func(t *testing.T) {
+		t.Helper()
+
+		_, err := getAsianGoalLineResult("ASIAN_GOAL_LINE", "Home 2.5", 1, 0)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "runner Home 2.5 not support for market ASIAN_GOAL_LINE", err.Error())
+	})
+}
@@ -0,0 +1,72 @@
+package strategy
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// BacktestRun is a stored backtest containing the query used to build the trades and the resulting Summary.
+// Trades are not populated when runs are listed.
+type BacktestRun struct {
+	ID        uuid.UUID     `json:"id"`
+	UserID    uuid.UUID     `json:"userId"`
+	Query     *BuilderQuery `json:"query"`
+	Summary   *Summary      `json:"summary"`
+	Trades    []*Trade      `json:"trades"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// MetricComparison compares a backtest metric across two runs, where Difference is the second value minus the
+// first.
+type MetricComparison struct {
+	Metric     string  `json:"metric"`
+	First      float64 `json:"first"`
+	Second     float64 `json:"second"`
+	Difference float64 `json:"difference"`
+}
+
+// CompareBacktestRuns compares the summary metrics of two runs. Significance metrics are only compared if both
+// runs include them.
+func CompareBacktestRuns(first, second *BacktestRun) []*MetricComparison {
+	a, b := first.Summary.Performance, second.Summary.Performance
+
+	metrics := []*MetricComparison{
+		newMetricComparison("TRADES", float64(a.Trades), float64(b.Trades)),
+		newMetricComparison("WINS", float64(a.Wins), float64(b.Wins)),
+		newMetricComparison("LOSSES", float64(a.Losses), float64(b.Losses)),
+		newMetricComparison("VOIDS", float64(a.Voids), float64(b.Voids)),
+		newMetricComparison("STRIKE_RATE", a.StrikeRate, b.StrikeRate),
+		newMetricComparison("PROFIT", a.Profit, b.Profit),
+		newMetricComparison("COMMISSION", a.Commission, b.Commission),
+		newMetricComparison("NET_PROFIT", a.NetProfit, b.NetProfit),
+		newMetricComparison("ROI", a.ROI, b.ROI),
+		newMetricComparison("YIELD", a.Yield, b.Yield),
+		newMetricComparison("MAX_DRAWDOWN", a.MaxDrawdown, b.MaxDrawdown),
+		newMetricComparison("LONGEST_WINNING_STREAK", float64(a.LongestWinningStreak), float64(b.LongestWinningStreak)),
+		newMetricComparison("LONGEST_LOSING_STREAK", float64(a.LongestLosingStreak), float64(b.LongestLosingStreak)),
+		newMetricComparison("AVERAGE_CLV", a.ClosingLine.AverageCLV, b.ClosingLine.AverageCLV),
+		newMetricComparison("BEAT_CLOSING_LINE_RATE", a.ClosingLine.BeatClosingLineRate, b.ClosingLine.BeatClosingLineRate),
+	}
+
+	sa, sb := first.Summary.Significance, second.Summary.Significance
+
+	if sa != nil && sb != nil {
+		metrics = append(
+			metrics,
+			newMetricComparison("P_VALUE", sa.PValue, sb.PValue),
+			newMetricComparison("ROI_LOWER", sa.ROILower, sb.ROILower),
+			newMetricComparison("ROI_UPPER", sa.ROIUpper, sb.ROIUpper),
+		)
+	}
+
+	return metrics
+}
+
+func newMetricComparison(metric string, first, second float64) *MetricComparison {
+	return &MetricComparison{
+		Metric:     metric,
+		First:      first,
+		Second:     second,
+		Difference: second - first,
+	}
+}
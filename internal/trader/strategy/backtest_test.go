@@ -0,0 +1,92 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCompareBacktestRuns(t *testing.T) {
+	t.Run("compares summary metrics of two backtest runs", func(t *testing.T) {
+		t.Helper()
+
+		first := &strategy.BacktestRun{
+			Summary: &strategy.Summary{
+				Performance: strategy.Performance{
+					Trades:      10,
+					Wins:        6,
+					Losses:      4,
+					StrikeRate:  0.6,
+					NetProfit:   2.5,
+					ROI:         0.25,
+					MaxDrawdown: 2,
+					ClosingLine: strategy.ClosingLineValue{AverageCLV: 1.5, BeatClosingLineRate: 60},
+				},
+			},
+		}
+
+		second := &strategy.BacktestRun{
+			Summary: &strategy.Summary{
+				Performance: strategy.Performance{
+					Trades:      20,
+					Wins:        9,
+					Losses:      10,
+					Voids:       1,
+					StrikeRate:  0.45,
+					NetProfit:   -1.5,
+					ROI:         -0.075,
+					MaxDrawdown: 4.5,
+				},
+			},
+		}
+
+		metrics := strategy.CompareBacktestRuns(first, second)
+
+		assert.Equal(t, 15, len(metrics))
+
+		compared := map[string]*strategy.MetricComparison{}
+
+		for _, m := range metrics {
+			compared[m.Metric] = m
+		}
+
+		assert.Equal(t, &strategy.MetricComparison{Metric: "TRADES", First: 10, Second: 20, Difference: 10}, compared["TRADES"])
+		assert.Equal(t, &strategy.MetricComparison{Metric: "VOIDS", First: 0, Second: 1, Difference: 1}, compared["VOIDS"])
+		assert.InDelta(t, -0.15, compared["STRIKE_RATE"].Difference, 0.0001)
+		assert.InDelta(t, -4.0, compared["NET_PROFIT"].Difference, 0.0001)
+		assert.InDelta(t, -0.325, compared["ROI"].Difference, 0.0001)
+		assert.InDelta(t, 2.5, compared["MAX_DRAWDOWN"].Difference, 0.0001)
+		assert.InDelta(t, -1.5, compared["AVERAGE_CLV"].Difference, 0.0001)
+		assert.InDelta(t, -60, compared["BEAT_CLOSING_LINE_RATE"].Difference, 0.0001)
+	})
+
+	t.Run("compares significance metrics if both runs include them", func(t *testing.T) {
+		t.Helper()
+
+		first := &strategy.BacktestRun{
+			Summary: &strategy.Summary{
+				Significance: &strategy.Significance{PValue: 0.2, ROILower: -0.1, ROIUpper: 0.3},
+			},
+		}
+
+		second := &strategy.BacktestRun{
+			Summary: &strategy.Summary{
+				Significance: &strategy.Significance{PValue: 0.05, ROILower: 0.01, ROIUpper: 0.2},
+			},
+		}
+
+		metrics := strategy.CompareBacktestRuns(first, second)
+
+		assert.Equal(t, 18, len(metrics))
+		assert.Equal(t, "P_VALUE", metrics[15].Metric)
+		assert.InDelta(t, -0.15, metrics[15].Difference, 0.0001)
+		assert.Equal(t, "ROI_LOWER", metrics[16].Metric)
+		assert.InDelta(t, 0.11, metrics[16].Difference, 0.0001)
+		assert.Equal(t, "ROI_UPPER", metrics[17].Metric)
+		assert.InDelta(t, -0.1, metrics[17].Difference, 0.0001)
+
+		second.Summary.Significance = nil
+
+		assert.Equal(t, 15, len(strategy.CompareBacktestRuns(first, second)))
+	})
+}
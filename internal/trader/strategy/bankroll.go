@@ -0,0 +1,194 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// BankrollBalance is the balance of a simulated bankroll after a trade has been settled. Profit is net of commission.
+type BankrollBalance struct {
+	EventID   uint64    `json:"eventId"`
+	EventDate time.Time `json:"eventDate"`
+	Stake     float64   `json:"stake"`
+	Profit    float64   `json:"profit"`
+	Balance   float64   `json:"balance"`
+}
+
+// BankrollSimulation is the result of replaying a set of trades against a starting bankroll using a StakingPlan.
+// MaxDrawdown is the largest peak-to-trough fall in the balance and MaxDrawdownPercentage is the largest fall as
+// a percentage of the peak it fell from, which can be a different fall to MaxDrawdown. The bankroll is Ruined once it can no longer cover the next stake, which is when a
+// FIXED stake is larger than the balance or a PERCENTAGE stake falls below MinimumSimulatedStake, and every trade
+// after that is Skipped.
+type BankrollSimulation struct {
+	StakingPlan           StakingPlan       `json:"stakingPlan"`
+	StartingBankroll      float64           `json:"startingBankroll"`
+	FinalBankroll         float64           `json:"finalBankroll"`
+	PeakBankroll          float64           `json:"peakBankroll"`
+	LowestBankroll        float64           `json:"lowestBankroll"`
+	MaxDrawdown           float64           `json:"maxDrawdown"`
+	MaxDrawdownPercentage float64           `json:"maxDrawdownPercentage"`
+	RiskOfRuin            float64           `json:"riskOfRuin"`
+	Ruined                bool              `json:"ruined"`
+	Skipped               int               `json:"skipped"`
+	Balances              []BankrollBalance `json:"balances"`
+}
+
+// SimulateBankroll replays trades in event date order against the bankroll provided, staking each trade using the
+// StakingPlan and deducting the commission each trade's exchange charges the user provided from winnings.
+// PERCENTAGE plans risk a percentage of the current balance and FIXED plans risk the same amount on every trade,
+// where the amount risked on a Lay trade is its liability.
+//
+// RiskOfRuin is estimated as ((1 - edge) / (1 + edge)) ^ units, where edge is the profit per unit risked at
+// level stakes after commission and units is the number of opening stakes the bankroll covers. A strategy without
+// an edge has a RiskOfRuin of 1.
+func SimulateBankroll(trades []*Trade, plan StakingPlan, bankroll float32, c Commission, userID string) (*BankrollSimulation, error) {
+	if err := validateBankroll(plan, bankroll); err != nil {
+		return nil, err
+	}
+
+	sorted := sortTradesByEventDate(trades)
+
+	sim, err := replayBankroll(sorted, plan, bankroll, c, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := calculatePerformance(sorted, c, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	units := 100 / float64(plan.Number)
+
+	if plan.Name == FixedStakingPlan {
+		units = float64(bankroll) / float64(plan.Number)
+	}
+
+	sim.RiskOfRuin = riskOfRuin(p.ROI/100, units)
+
+	return sim, nil
+}
+
+func validateBankroll(plan StakingPlan, bankroll float32) error {
+	if plan.Name != PercentageStakingPlan && plan.Name != FixedStakingPlan {
+		return fmt.Errorf("staking plan '%s' is not supported", plan.Name)
+	}
+
+	if plan.Number <= 0 {
+		return errors.New("staking plan must be greater than zero")
+	}
+
+	if bankroll <= 0 {
+		return errors.New("bankroll must be greater than zero")
+	}
+
+	return nil
+}
+
+// MinimumSimulatedStake is the smallest amount a simulated PERCENTAGE staking plan can risk on a trade, matching
+// the minimum stake accepted by exchanges.
+const MinimumSimulatedStake = 1
+
+// replayBankroll replays trades in the order provided.
+func replayBankroll(trades []*Trade, plan StakingPlan, bankroll float32, c Commission, userID string) (*BankrollSimulation, error) {
+	sim := BankrollSimulation{
+		StakingPlan:      plan,
+		StartingBankroll: float64(bankroll),
+		FinalBankroll:    float64(bankroll),
+		PeakBankroll:     float64(bankroll),
+		LowestBankroll:   float64(bankroll),
+		Balances:         []BankrollBalance{},
+	}
+
+	sim.Ruined = !coversStake(plan, sim.FinalBankroll)
+
+	for _, t := range trades {
+		if sim.Ruined {
+			sim.Skipped++
+			continue
+		}
+
+		risk := calculateSimulatedRisk(plan, sim.FinalBankroll)
+
+		stake := float32(risk)
+
+		if t.Side == Lay {
+			stake = stake / (t.Price - 1)
+		}
+
+		profit, err := CalculateProfit(t.Side, t.Price, stake, t.Result)
+
+		if err != nil {
+			return nil, err
+		}
+
+		net := CalculateNetProfit(profit, c.Rate(t.Exchange, userID))
+
+		sim.FinalBankroll += float64(net)
+
+		sim.Balances = append(sim.Balances, BankrollBalance{
+			EventID:   t.EventID,
+			EventDate: t.EventDate,
+			Stake:     float64(stake),
+			Profit:    float64(net),
+			Balance:   sim.FinalBankroll,
+		})
+
+		if sim.FinalBankroll > sim.PeakBankroll {
+			sim.PeakBankroll = sim.FinalBankroll
+		}
+
+		drawdown := sim.PeakBankroll - sim.FinalBankroll
+
+		if drawdown > sim.MaxDrawdown {
+			sim.MaxDrawdown = drawdown
+		}
+
+		if percentage := drawdown / sim.PeakBankroll * 100; percentage > sim.MaxDrawdownPercentage {
+			sim.MaxDrawdownPercentage = percentage
+		}
+
+		if sim.FinalBankroll < sim.LowestBankroll {
+			sim.LowestBankroll = sim.FinalBankroll
+		}
+
+		sim.Ruined = !coversStake(plan, sim.FinalBankroll)
+	}
+
+	return &sim, nil
+}
+
+func calculateSimulatedRisk(plan StakingPlan, balance float64) float64 {
+	if plan.Name == FixedStakingPlan {
+		return float64(plan.Number)
+	}
+
+	return balance / 100 * float64(plan.Number)
+}
+
+// coversStake returns true if the balance provided can cover the next stake of the StakingPlan.
+func coversStake(plan StakingPlan, balance float64) bool {
+	risk := calculateSimulatedRisk(plan, balance)
+
+	if plan.Name == FixedStakingPlan {
+		return risk <= balance
+	}
+
+	return risk >= MinimumSimulatedStake
+}
+
+func riskOfRuin(edge, units float64) float64 {
+	if edge <= 0 {
+		return 1
+	}
+
+	if edge >= 1 {
+		return 0
+	}
+
+	return math.Pow((1-edge)/(1+edge), units)
+}
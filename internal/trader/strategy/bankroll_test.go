@@ -0,0 +1,236 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSimulateBankroll(t *testing.T) {
+	trades := []*strategy.Trade{
+		newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+		newSummaryTrade(1, 8, 17420, "BACK", 3.00, "SUCCESS"),
+		newSummaryTrade(2, 8, 17420, "BACK", 2.00, "VOID"),
+		newSummaryTrade(4, 8, 17420, "BACK", 2.00, "SUCCESS"),
+	}
+
+	t.Run("replays trades in event date order using a percentage staking plan", func(t *testing.T) {
+		t.Helper()
+
+		plan := strategy.StakingPlan{Name: "PERCENTAGE", Number: 10}
+
+		sim, err := strategy.SimulateBankroll(trades, plan, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(plan, sim.StakingPlan)
+		a.Equal(4, len(sim.Balances))
+		a.InDelta(10.0, sim.Balances[0].Stake, 0.0001)
+		a.InDelta(120.0, sim.Balances[0].Balance, 0.0001)
+		a.InDelta(120.0, sim.Balances[1].Balance, 0.0001)
+		a.InDelta(12.0, sim.Balances[2].Stake, 0.0001)
+		a.InDelta(108.0, sim.Balances[2].Balance, 0.0001)
+		a.InDelta(118.8, sim.Balances[3].Balance, 0.0001)
+		a.InDelta(100.0, sim.StartingBankroll, 0.0001)
+		a.InDelta(118.8, sim.FinalBankroll, 0.0001)
+		a.InDelta(120.0, sim.PeakBankroll, 0.0001)
+		a.InDelta(12.0, sim.MaxDrawdown, 0.0001)
+		a.InDelta(10.0, sim.MaxDrawdownPercentage, 0.0001)
+		a.InDelta(0.0, sim.RiskOfRuin, 0.0001)
+		a.False(sim.Ruined)
+		a.Equal(0, sim.Skipped)
+	})
+
+	t.Run("replays trades using a fixed staking plan", func(t *testing.T) {
+		t.Helper()
+
+		plan := strategy.StakingPlan{Name: "FIXED", Number: 50}
+
+		sim, err := strategy.SimulateBankroll(trades, plan, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(200.0, sim.Balances[0].Balance, 0.0001)
+		a.InDelta(150.0, sim.Balances[2].Balance, 0.0001)
+		a.InDelta(200.0, sim.FinalBankroll, 0.0001)
+		a.InDelta(50.0, sim.MaxDrawdown, 0.0001)
+		a.InDelta(25.0, sim.MaxDrawdownPercentage, 0.0001)
+		a.InDelta(0.04, sim.RiskOfRuin, 0.0001)
+	})
+
+	t.Run("calculates the largest percentage drawdown separately to the largest drawdown", func(t *testing.T) {
+		t.Helper()
+
+		// Balance 100, 90, 81, 162, 324, 291.6 so the largest fall is 32.4 (10%) but the largest percentage fall is 19%
+		sequence := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 11.00, "SUCCESS"),
+			newSummaryTrade(4, 8, 17420, "BACK", 11.00, "SUCCESS"),
+			newSummaryTrade(5, 8, 17420, "BACK", 2.00, "FAIL"),
+		}
+
+		sim, err := strategy.SimulateBankroll(sequence, strategy.StakingPlan{Name: "PERCENTAGE", Number: 10}, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(291.6, sim.FinalBankroll, 0.001)
+		a.InDelta(324.0, sim.PeakBankroll, 0.001)
+		a.InDelta(32.4, sim.MaxDrawdown, 0.001)
+		a.InDelta(19.0, sim.MaxDrawdownPercentage, 0.001)
+	})
+
+	t.Run("skips trades once the bankroll is ruined", func(t *testing.T) {
+		t.Helper()
+
+		losing := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		}
+
+		sim, err := strategy.SimulateBankroll(losing, strategy.StakingPlan{Name: "FIXED", Number: 50}, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.True(sim.Ruined)
+		a.Equal(1, sim.Skipped)
+		a.Equal(2, len(sim.Balances))
+		a.InDelta(0.0, sim.FinalBankroll, 0.0001)
+		a.InDelta(100.0, sim.MaxDrawdownPercentage, 0.0001)
+		a.Equal(1.0, sim.RiskOfRuin)
+	})
+
+	t.Run("ruins a fixed staking plan once the balance cannot cover the next stake", func(t *testing.T) {
+		t.Helper()
+
+		losing := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		}
+
+		sim, err := strategy.SimulateBankroll(losing, strategy.StakingPlan{Name: "FIXED", Number: 40}, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.True(sim.Ruined)
+		a.Equal(1, sim.Skipped)
+		a.Equal(2, len(sim.Balances))
+		a.InDelta(20.0, sim.FinalBankroll, 0.0001)
+	})
+
+	t.Run("ruins a percentage staking plan once the stake falls below the minimum stake", func(t *testing.T) {
+		t.Helper()
+
+		losing := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(4, 8, 17420, "BACK", 2.00, "SUCCESS"),
+			newSummaryTrade(5, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		}
+
+		sim, err := strategy.SimulateBankroll(losing, strategy.StakingPlan{Name: "PERCENTAGE", Number: 50}, 10, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.True(sim.Ruined)
+		a.Equal(2, sim.Skipped)
+		a.Equal(3, len(sim.Balances))
+		a.InDelta(1.25, sim.FinalBankroll, 0.0001)
+	})
+
+	t.Run("stakes lay trades so the liability matches the amount risked", func(t *testing.T) {
+		t.Helper()
+
+		lay := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "LAY", 11.00, "SUCCESS"),
+			newSummaryTrade(2, 8, 17420, "LAY", 3.00, "FAIL"),
+		}
+
+		sim, err := strategy.SimulateBankroll(lay, strategy.StakingPlan{Name: "FIXED", Number: 10}, 100, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(0, sim.Skipped)
+		a.InDelta(1.0, sim.Balances[0].Stake, 0.0001)
+		a.InDelta(101.0, sim.Balances[0].Balance, 0.0001)
+		a.InDelta(5.0, sim.Balances[1].Stake, 0.0001)
+		a.InDelta(91.0, sim.FinalBankroll, 0.0001)
+	})
+
+	t.Run("deducts commission from winning trades", func(t *testing.T) {
+		t.Helper()
+
+		c := strategy.Commission{
+			Rates:     map[string]float32{"betfair": 5},
+			Discounts: map[string]float32{"user-1": 20},
+		}
+
+		sim, err := strategy.SimulateBankroll(trades, strategy.StakingPlan{Name: "FIXED", Number: 50}, 100, c, "user-1")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(96.0, sim.Balances[0].Profit, 0.0001)
+		a.InDelta(196.0, sim.Balances[0].Balance, 0.0001)
+		a.InDelta(-50.0, sim.Balances[2].Profit, 0.0001)
+		a.InDelta(194.0, sim.FinalBankroll, 0.0001)
+	})
+
+	t.Run("returns an error if the staking plan is not supported", func(t *testing.T) {
+		t.Helper()
+
+		_, err := strategy.SimulateBankroll(trades, strategy.StakingPlan{Name: "KELLY", Number: 10}, 100, strategy.Commission{}, "")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "staking plan 'KELLY' is not supported", err.Error())
+	})
+
+	t.Run("returns an error if the bankroll is not greater than zero", func(t *testing.T) {
+		t.Helper()
+
+		_, err := strategy.SimulateBankroll(trades, strategy.StakingPlan{Name: "FIXED", Number: 10}, 0, strategy.Commission{}, "")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "bankroll must be greater than zero", err.Error())
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/statistico/statistico-odds-warehouse-go-grpc-client"
 	"github.com/statistico/statistico-proto/go"
 	"sync"
+	"time"
 )
 
 type Builder interface {
@@ -16,6 +17,7 @@ type Builder interface {
 type builder struct {
 	matcher    FilterMatcher
 	parser     ResultParser
+	closing    ClosingPriceFinder
 	marketClient statisticooddswarehouse.MarketClient
 	logger     *logrus.Logger
 }
@@ -57,11 +59,32 @@ func (b *builder) build(ctx context.Context, ch chan<- *Trade, q *BuilderQuery)
 	wg.Wait()
 }
 
-func (b *builder) handleMarket(ctx context.Context, ch chan<- *Trade, mk *statistico.MarketRunner, q *BuilderQuery) {
+func (b *builder) handleMarket(
+	ctx context.Context,
+	ch chan<- *Trade,
+	mk *statistico.MarketRunner,
+	q *BuilderQuery,
+) {
+	allowed, err := q.TradeWindow.Allows(mk.EventDate.AsTime(), time.Unix(mk.Price.GetTimestamp(), 0))
+
+	if err != nil {
+		b.log(mk.MarketName, mk.RunnerName, mk.EventId, err)
+		return
+	}
+
+	if !allowed {
+		return
+	}
+
 	query := MatcherQuery{
-		EventID:       mk.EventId,
-		ResultFilters: q.ResultFilters,
-		StatFilters:   q.StatFilters,
+		EventID:                mk.EventId,
+		ResultFilters:          q.ResultFilters,
+		StatFilters:            q.StatFilters,
+		HeadToHeadFilters:      q.HeadToHeadFilters,
+		LeagueTableFilters:     q.LeagueTableFilters,
+		ComparativeStatFilters: q.ComparativeStatFilters,
+		ScheduleFilters:        q.ScheduleFilters,
+		FilterGroups:           q.FilterGroups,
 	}
 
 	matches, err := b.matcher.MatchesFilters(ctx, &query)
@@ -92,10 +115,36 @@ func (b *builder) handleMarket(ctx context.Context, ch chan<- *Trade, mk *statis
 			Result:        result,
 		}
 
+		tr.ClosingPrice = b.closingPrice(ctx, tr, q.Line)
+
 		ch <- tr
 	}
 }
 
+// closingPrice returns the closing price of a matched Trade. Trades built from the closing line use their own price
+// and a Trade is built without a closing price if an error is returned by the odds warehouse.
+func (b *builder) closingPrice(ctx context.Context, tr *Trade, line string) *float32 {
+	if line == ClosingLine {
+		price := tr.Price
+		return &price
+	}
+
+	price, err := b.closing.ClosingPrice(ctx, &ClosingPriceQuery{
+		EventID:   tr.EventID,
+		EventDate: tr.EventDate,
+		Market:    tr.MarketName,
+		Runner:    tr.RunnerName,
+		Side:      tr.Side,
+		Exchange:  tr.Exchange,
+	})
+
+	if err != nil {
+		b.log(tr.MarketName, tr.RunnerName, tr.EventID, err)
+	}
+
+	return price
+}
+
 func (b *builder) log(market, runner string, eventID uint64, e error) {
 	b.logger.Infof(
 		"error handling trade for market %s, runner %s and event %d: %+v",
@@ -127,10 +176,38 @@ func buildMarketRequest(q *BuilderQuery) *statistico.MarketRunnerRequest {
 	return &req
 }
 
-func NewBuilder(m FilterMatcher, p ResultParser, o statisticooddswarehouse.MarketClient, l *logrus.Logger) Builder {
+// NewBuilderQuery returns the BuilderQuery used to backtest a saved Strategy using prices from the line provided.
+func NewBuilderQuery(s *Strategy, line string) *BuilderQuery {
+	return &BuilderQuery{
+		Market:                 s.MarketName,
+		Runner:                 s.RunnerName,
+		MinOdds:                s.MinOdds,
+		MaxOdds:                s.MaxOdds,
+		Line:                   line,
+		Side:                   s.Side,
+		CompetitionIDs:         s.CompetitionIDs,
+		TradeWindow:            s.TradeWindow,
+		ResultFilters:          s.ResultFilters,
+		StatFilters:            s.StatFilters,
+		HeadToHeadFilters:      s.HeadToHeadFilters,
+		LeagueTableFilters:     s.LeagueTableFilters,
+		ComparativeStatFilters: s.ComparativeStatFilters,
+		ScheduleFilters:        s.ScheduleFilters,
+		FilterGroups:           s.FilterGroups,
+	}
+}
+
+func NewBuilder(
+	m FilterMatcher,
+	p ResultParser,
+	c ClosingPriceFinder,
+	o statisticooddswarehouse.MarketClient,
+	l *logrus.Logger,
+) Builder {
 	return &builder{
 		matcher:      m,
 		parser:       p,
+		closing:      c,
 		marketClient: o,
 		logger:       l,
 	}
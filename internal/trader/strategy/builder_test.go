@@ -42,10 +42,11 @@ func TestBuilder_Build(t *testing.T) {
 
 		matcher := new(MockFilterMatcher)
 		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
 		marketClient := new(MockMarketClient)
 		logger, hook := test.NewNullLogger()
 
-		builder := strategy.NewBuilder(matcher, parser, marketClient, logger)
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
 
 		ctx := context.Background()
 
@@ -129,6 +130,7 @@ func TestBuilder_Build(t *testing.T) {
 		a.Equal(float32(1.95), tr.Price)
 		a.Equal("BACK", tr.Side)
 		a.Equal(strategy.Result("SUCCESS"), tr.Result)
+		a.Equal(float32(1.95), *tr.ClosingPrice)
 		a.Equal(0, len(hook.AllEntries()))
 
 		matcher.AssertExpectations(t)
@@ -136,15 +138,153 @@ func TestBuilder_Build(t *testing.T) {
 		parser.AssertExpectations(t)
 	})
 
+	t.Run("closing prices are fetched from the closing line if trades are priced from another line", func(t *testing.T) {
+		t.Helper()
+
+		matcher := new(MockFilterMatcher)
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		marketClient := new(MockMarketClient)
+		logger, hook := test.NewNullLogger()
+
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
+
+		ctx := context.Background()
+
+		min := float32(1.50)
+
+		query := strategy.BuilderQuery{
+			Market:         "MATCH_ODDS",
+			Runner:         "Home",
+			MinOdds:        &min,
+			Line:           "MAX",
+			Side:           "BACK",
+			CompetitionIDs: []uint64{8},
+		}
+
+		newMarket := func(eventID uint64, price float32) *statistico.MarketRunner {
+			return &statistico.MarketRunner{
+				MarketName:    "MATCH_ODDS",
+				RunnerName:    "Home",
+				EventId:       eventID,
+				CompetitionId: 8,
+				SeasonId:      17420,
+				EventDate:     timestamppb.New(time.Unix(1617126949, 0)),
+				Exchange:      "betfair",
+				Price:         &statistico.Price{Value: price, Timestamp: 1617126949},
+			}
+		}
+
+		maxReq := mock.MatchedBy(func(r *statistico.MarketRunnerRequest) bool {
+			return r.GetLine() == "MAX" && r.GetMinOdds().GetValue() == min
+		})
+
+		closingQuery := func(eventID uint64) *strategy.ClosingPriceQuery {
+			return &strategy.ClosingPriceQuery{
+				EventID:   eventID,
+				EventDate: time.Unix(1617126949, 0).UTC(),
+				Market:    "MATCH_ODDS",
+				Runner:    "Home",
+				Side:      "BACK",
+				Exchange:  "betfair",
+			}
+		}
+
+		price := float32(1.80)
+
+		marketClient.On("MarketRunnerSearch", ctx, maxReq, 5000).
+			Return(marketChannel([]*statistico.MarketRunner{newMarket(1234, 2.05), newMarket(5678, 1.70)}), errChan(nil))
+		closing.On("ClosingPrice", ctx, closingQuery(1234)).Return(&price, nil)
+		closing.On("ClosingPrice", ctx, closingQuery(5678)).Return(nil, nil)
+
+		matcher.On("MatchesFilters", ctx, mock.AnythingOfType("*strategy.MatcherQuery")).Return(true, nil)
+		parser.On("Parse", ctx, mock.AnythingOfType("uint64"), "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("SUCCESS"), nil)
+
+		trades := map[uint64]*strategy.Trade{}
+
+		for tr := range builder.Build(ctx, &query) {
+			trades[tr.EventID] = tr
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(trades))
+		a.Equal(float32(2.05), trades[1234].Price)
+		a.Equal(float32(1.80), *trades[1234].ClosingPrice)
+		a.Equal(float32(1.70), trades[5678].Price)
+		a.Nil(trades[5678].ClosingPrice)
+		a.Equal(0, len(hook.AllEntries()))
+
+		marketClient.AssertExpectations(t)
+		closing.AssertExpectations(t)
+	})
+
+	t.Run("trade is built without a closing price if error is returned by closing price finder", func(t *testing.T) {
+		t.Helper()
+
+		matcher := new(MockFilterMatcher)
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		marketClient := new(MockMarketClient)
+		logger, hook := test.NewNullLogger()
+
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
+
+		ctx := context.Background()
+
+		query := strategy.BuilderQuery{
+			Market:         "MATCH_ODDS",
+			Runner:         "Home",
+			Line:           "MAX",
+			Side:           "BACK",
+			CompetitionIDs: []uint64{8},
+		}
+
+		market := &statistico.MarketRunner{
+			MarketName:    "MATCH_ODDS",
+			RunnerName:    "Home",
+			EventId:       1234,
+			CompetitionId: 8,
+			SeasonId:      17420,
+			EventDate:     timestamppb.New(time.Unix(1617126949, 0)),
+			Exchange:      "betfair",
+			Price:         &statistico.Price{Value: 2.05, Timestamp: 1617126949},
+		}
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 5000).
+			Return(marketChannel([]*statistico.MarketRunner{market}), errChan(nil))
+		matcher.On("MatchesFilters", ctx, mock.AnythingOfType("*strategy.MatcherQuery")).Return(true, nil)
+		parser.On("Parse", ctx, uint64(1234), "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("SUCCESS"), nil)
+		closing.On("ClosingPrice", ctx, mock.AnythingOfType("*strategy.ClosingPriceQuery")).
+			Return(nil, errors.New("error fetching closing price for event 1234: odds warehouse unavailable"))
+
+		trades := []*strategy.Trade{}
+
+		for tr := range builder.Build(ctx, &query) {
+			trades = append(trades, tr)
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(trades))
+		a.Nil(trades[0].ClosingPrice)
+		a.Equal(
+			"error handling trade for market MATCH_ODDS, runner Home and event 1234: error fetching closing price for event 1234: odds warehouse unavailable",
+			hook.LastEntry().Message,
+		)
+		a.Equal(logrus.InfoLevel, hook.LastEntry().Level)
+	})
+
 	t.Run("error is logged if error is returned on error channel returned by market client", func(t *testing.T) {
 		t.Helper()
 
 		matcher := new(MockFilterMatcher)
 		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
 		marketClient := new(MockMarketClient)
 		logger, hook := test.NewNullLogger()
 
-		builder := strategy.NewBuilder(matcher, parser, marketClient, logger)
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
 
 		ctx := context.Background()
 
@@ -242,10 +382,11 @@ func TestBuilder_Build(t *testing.T) {
 
 		matcher := new(MockFilterMatcher)
 		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
 		marketClient := new(MockMarketClient)
 		logger, hook := test.NewNullLogger()
 
-		builder := strategy.NewBuilder(matcher, parser, marketClient, logger)
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
 
 		ctx := context.Background()
 
@@ -324,15 +465,76 @@ func TestBuilder_Build(t *testing.T) {
 		marketClient.AssertExpectations(t)
 	})
 
+	t.Run("trade is not pushed into channel if market price is outside of trade window", func(t *testing.T) {
+		t.Helper()
+
+		matcher := new(MockFilterMatcher)
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		marketClient := new(MockMarketClient)
+		logger, hook := test.NewNullLogger()
+
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
+
+		ctx := context.Background()
+
+		min := uint32(30)
+
+		query := strategy.BuilderQuery{
+			Market:         "MATCH_ODDS",
+			Runner:         "Home",
+			Line:           "CLOSING",
+			Side:           "BACK",
+			CompetitionIDs: []uint64{8},
+			TradeWindow:    strategy.TradeWindow{MinMinutesBeforeKickoff: &min},
+			ResultFilters:  resultFilters,
+		}
+
+		markets := []*statistico.MarketRunner{
+			{
+				MarketId:      "1.2345",
+				MarketName:    "MATCH_ODDS",
+				RunnerId:      1,
+				RunnerName:    "Home",
+				EventId:       1234,
+				CompetitionId: 8,
+				SeasonId:      17420,
+				EventDate:     timestamppb.New(time.Unix(1617126949, 0)),
+				Exchange:      "betfair",
+				Price: &statistico.Price{
+					Value:     1.95,
+					Size:      500.03,
+					Side:      statistico.SideEnum_BACK,
+					Timestamp: 1617126949 - 600,
+				},
+			},
+		}
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 5000).
+			Return(marketChannel(markets), errChan(nil))
+
+		tradeCh := builder.Build(ctx, &query)
+
+		tr := <-tradeCh
+
+		assert.Nil(t, tr)
+		assert.Equal(t, 0, len(hook.AllEntries()))
+
+		matcher.AssertNotCalled(t, "MatchesFilters", mock.Anything, mock.Anything)
+		parser.AssertNotCalled(t, "Parse")
+		marketClient.AssertExpectations(t)
+	})
+
 	t.Run("info is logged if error is returned by filter matcher", func(t *testing.T) {
 		t.Helper()
 
 		matcher := new(MockFilterMatcher)
 		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
 		marketClient := new(MockMarketClient)
 		logger, hook := test.NewNullLogger()
 
-		builder := strategy.NewBuilder(matcher, parser, marketClient, logger)
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
 
 		ctx := context.Background()
 
@@ -417,10 +619,11 @@ func TestBuilder_Build(t *testing.T) {
 
 		matcher := new(MockFilterMatcher)
 		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
 		marketClient := new(MockMarketClient)
 		logger, hook := test.NewNullLogger()
 
-		builder := strategy.NewBuilder(matcher, parser, marketClient, logger)
+		builder := strategy.NewBuilder(matcher, parser, closing, marketClient, logger)
 
 		ctx := context.Background()
 
@@ -511,6 +714,20 @@ func (m *MockResultParser) Parse(ctx context.Context, eventID uint64, market, ru
 	return args.Get(0).(strategy.Result), args.Error(1)
 }
 
+type MockClosingPriceFinder struct {
+	mock.Mock
+}
+
+func (m *MockClosingPriceFinder) ClosingPrice(ctx context.Context, q *strategy.ClosingPriceQuery) (*float32, error) {
+	args := m.Called(ctx, q)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*float32), args.Error(1)
+}
+
 type MockMarketClient struct {
 	mock.Mock
 }
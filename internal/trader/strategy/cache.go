@@ -0,0 +1,251 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/statistico/statistico-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"sync"
+)
+
+// The cached clients wrap data service clients so that repeated lookups made while building many strategies
+// over the same events, such as during an optimisation, are only requested once. Errors are not cached and
+// slices are copied before being returned so callers are free to sort them.
+
+type cachedFixtureClient struct {
+	client   statisticodata.FixtureClient
+	fixtures map[uint64]*statistico.Fixture
+	searches map[string][]*statistico.Fixture
+	lock     sync.Mutex
+}
+
+func (c *cachedFixtureClient) Search(ctx context.Context, req *statistico.FixtureSearchRequest) ([]*statistico.Fixture, error) {
+	c.lock.Lock()
+	fx, ok := c.searches[req.String()]
+	c.lock.Unlock()
+
+	if ok {
+		return copyFixtures(fx), nil
+	}
+
+	fx, err := c.client.Search(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.searches[req.String()] = fx
+	c.lock.Unlock()
+
+	return copyFixtures(fx), nil
+}
+
+func (c *cachedFixtureClient) ByID(ctx context.Context, fixtureID uint64) (*statistico.Fixture, error) {
+	c.lock.Lock()
+	fx, ok := c.fixtures[fixtureID]
+	c.lock.Unlock()
+
+	if ok {
+		return fx, nil
+	}
+
+	fx, err := c.client.ByID(ctx, fixtureID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.fixtures[fixtureID] = fx
+	c.lock.Unlock()
+
+	return fx, nil
+}
+
+type cachedResultClient struct {
+	client  statisticodata.ResultClient
+	results map[uint64]*statistico.Result
+	teams   map[string][]*statistico.Result
+	lock    sync.Mutex
+}
+
+func (c *cachedResultClient) ByID(ctx context.Context, fixtureID uint64) (*statistico.Result, error) {
+	c.lock.Lock()
+	res, ok := c.results[fixtureID]
+	c.lock.Unlock()
+
+	if ok {
+		return res, nil
+	}
+
+	res, err := c.client.ByID(ctx, fixtureID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.results[fixtureID] = res
+	c.lock.Unlock()
+
+	return res, nil
+}
+
+func (c *cachedResultClient) ByTeam(ctx context.Context, req *statistico.TeamResultRequest) ([]*statistico.Result, error) {
+	c.lock.Lock()
+	res, ok := c.teams[req.String()]
+	c.lock.Unlock()
+
+	if ok {
+		return copyResults(res), nil
+	}
+
+	res, err := c.client.ByTeam(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.teams[req.String()] = res
+	c.lock.Unlock()
+
+	return copyResults(res), nil
+}
+
+type cachedSeasonClient struct {
+	client  statisticodata.SeasonClient
+	seasons map[string][]*statistico.Season
+	lock    sync.Mutex
+}
+
+func (c *cachedSeasonClient) ByTeamID(ctx context.Context, teamID uint64, sort string) ([]*statistico.Season, error) {
+	return c.fetch(fmt.Sprintf("team-%d-%s", teamID, sort), func() ([]*statistico.Season, error) {
+		return c.client.ByTeamID(ctx, teamID, sort)
+	})
+}
+
+func (c *cachedSeasonClient) ByCompetitionID(ctx context.Context, competitionID uint64, sort string) ([]*statistico.Season, error) {
+	return c.fetch(fmt.Sprintf("competition-%d-%s", competitionID, sort), func() ([]*statistico.Season, error) {
+		return c.client.ByCompetitionID(ctx, competitionID, sort)
+	})
+}
+
+func (c *cachedSeasonClient) fetch(key string, fn func() ([]*statistico.Season, error)) ([]*statistico.Season, error) {
+	c.lock.Lock()
+	s, ok := c.seasons[key]
+	c.lock.Unlock()
+
+	if !ok {
+		var err error
+
+		if s, err = fn(); err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		c.seasons[key] = s
+		c.lock.Unlock()
+	}
+
+	cp := make([]*statistico.Season, len(s))
+	copy(cp, s)
+
+	return cp, nil
+}
+
+type cachedTeamClient struct {
+	client  statisticodata.TeamClient
+	teams   map[uint64]*statistico.Team
+	seasons map[uint64][]*statistico.Team
+	lock    sync.Mutex
+}
+
+func (c *cachedTeamClient) ByID(ctx context.Context, teamID uint64) (*statistico.Team, error) {
+	c.lock.Lock()
+	t, ok := c.teams[teamID]
+	c.lock.Unlock()
+
+	if ok {
+		return t, nil
+	}
+
+	t, err := c.client.ByID(ctx, teamID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.teams[teamID] = t
+	c.lock.Unlock()
+
+	return t, nil
+}
+
+func (c *cachedTeamClient) BySeasonID(ctx context.Context, seasonID uint64) ([]*statistico.Team, error) {
+	c.lock.Lock()
+	t, ok := c.seasons[seasonID]
+	c.lock.Unlock()
+
+	if !ok {
+		var err error
+
+		if t, err = c.client.BySeasonID(ctx, seasonID); err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		c.seasons[seasonID] = t
+		c.lock.Unlock()
+	}
+
+	cp := make([]*statistico.Team, len(t))
+	copy(cp, t)
+
+	return cp, nil
+}
+
+func copyFixtures(f []*statistico.Fixture) []*statistico.Fixture {
+	cp := make([]*statistico.Fixture, len(f))
+	copy(cp, f)
+	return cp
+}
+
+func copyResults(r []*statistico.Result) []*statistico.Result {
+	cp := make([]*statistico.Result, len(r))
+	copy(cp, r)
+	return cp
+}
+
+func NewCachedFixtureClient(c statisticodata.FixtureClient) statisticodata.FixtureClient {
+	return &cachedFixtureClient{
+		client:   c,
+		fixtures: map[uint64]*statistico.Fixture{},
+		searches: map[string][]*statistico.Fixture{},
+	}
+}
+
+func NewCachedResultClient(c statisticodata.ResultClient) statisticodata.ResultClient {
+	return &cachedResultClient{
+		client:  c,
+		results: map[uint64]*statistico.Result{},
+		teams:   map[string][]*statistico.Result{},
+	}
+}
+
+func NewCachedSeasonClient(c statisticodata.SeasonClient) statisticodata.SeasonClient {
+	return &cachedSeasonClient{
+		client:  c,
+		seasons: map[string][]*statistico.Season{},
+	}
+}
+
+func NewCachedTeamClient(c statisticodata.TeamClient) statisticodata.TeamClient {
+	return &cachedTeamClient{
+		client:  c,
+		teams:   map[uint64]*statistico.Team{},
+		seasons: map[uint64][]*statistico.Team{},
+	}
+}
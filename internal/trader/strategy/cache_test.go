@@ -0,0 +1,130 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCachedResultClient_ByID(t *testing.T) {
+	t.Run("returns cached result for repeated lookups", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		cached := strategy.NewCachedResultClient(client)
+
+		ctx := context.Background()
+		res := &statistico.Result{Id: 18279}
+
+		client.On("ByID", ctx, uint64(18279)).Once().Return(res, nil)
+
+		for i := 0; i < 3; i++ {
+			r, err := cached.ByID(ctx, 18279)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, res, r)
+		}
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		cached := strategy.NewCachedResultClient(client)
+
+		ctx := context.Background()
+		res := &statistico.Result{Id: 18279}
+
+		client.On("ByID", ctx, uint64(18279)).Once().Return(&statistico.Result{}, errors.New("oh no"))
+		client.On("ByID", ctx, uint64(18279)).Once().Return(res, nil)
+
+		_, err := cached.ByID(ctx, 18279)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		r, err := cached.ByID(ctx, 18279)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, res, r)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestCachedResultClient_ByTeam(t *testing.T) {
+	t.Run("returns a copy of cached results for repeated requests", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		cached := strategy.NewCachedResultClient(client)
+
+		ctx := context.Background()
+		results := []*statistico.Result{{Id: 1}, {Id: 2}}
+
+		client.On("ByTeam", ctx, &statistico.TeamResultRequest{TeamId: 1}).Once().Return(results, nil)
+		client.On("ByTeam", ctx, &statistico.TeamResultRequest{TeamId: 2}).Once().Return(results[:1], nil)
+
+		first, err := cached.ByTeam(ctx, &statistico.TeamResultRequest{TeamId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		first[0], first[1] = first[1], first[0]
+
+		second, err := cached.ByTeam(ctx, &statistico.TeamResultRequest{TeamId: 1})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		other, err := cached.ByTeam(ctx, &statistico.TeamResultRequest{TeamId: 2})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, results, second)
+		assert.Equal(t, 1, len(other))
+		client.AssertExpectations(t)
+	})
+}
+
+func TestCachedSeasonClient_ByCompetitionID(t *testing.T) {
+	t.Run("returns cached seasons for repeated lookups", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.SeasonClient)
+		cached := strategy.NewCachedSeasonClient(client)
+
+		ctx := context.Background()
+		seasons := []*statistico.Season{{Id: 17420}, {Id: 17421}}
+
+		client.On("ByCompetitionID", ctx, uint64(8), "name_asc").Once().Return(seasons, nil)
+
+		for i := 0; i < 2; i++ {
+			s, err := cached.ByCompetitionID(ctx, 8, "name_asc")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, seasons, s)
+		}
+
+		client.AssertExpectations(t)
+	})
+}
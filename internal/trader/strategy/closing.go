@@ -0,0 +1,166 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/statistico/statistico-odds-warehouse-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+// ClosingLine is the odds warehouse line containing the final price of a runner before kickoff.
+const ClosingLine = "CLOSING"
+
+// closingPriceWindow is how far either side of kickoff the odds warehouse is searched for a closing price. The
+// DateFrom and DateTo of a MarketRunnerRequest filter markets by event date rather than by the timestamp of a
+// price, so the closing price of a market is found as long as the warehouse records the same kickoff time as the
+// trade. The window allows for kickoff times stored at different precisions and no price is found if the
+// warehouse's event date differs by more than a minute.
+const closingPriceWindow = time.Minute
+
+type ClosingPriceFinder interface {
+	// ClosingPrice returns the final price of a runner before kickoff or nil if the odds warehouse does not
+	// contain a closing price for the event.
+	ClosingPrice(ctx context.Context, q *ClosingPriceQuery) (*float32, error)
+}
+
+type ClosingPriceQuery struct {
+	EventID   uint64
+	EventDate time.Time
+	Market    string
+	Runner    string
+	Side      string
+	Exchange  string
+}
+
+type closingPriceFinder struct {
+	marketClient statisticooddswarehouse.MarketClient
+	searches     map[closingSearchKey]map[closingRunnerKey]float32
+	lock         sync.Mutex
+}
+
+// closingSearchKey identifies a search of the closing line for every event kicking off at the same time.
+type closingSearchKey struct {
+	market    string
+	runner    string
+	side      string
+	eventDate int64
+}
+
+// closingRunnerKey identifies the closing price of a runner for an event on an exchange.
+type closingRunnerKey struct {
+	eventID  uint64
+	exchange string
+}
+
+func (c *closingPriceFinder) ClosingPrice(ctx context.Context, q *ClosingPriceQuery) (*float32, error) {
+	prices, err := c.search(ctx, q)
+
+	if err != nil {
+		return nil, fmt.Errorf("error fetching closing price for event %d: %s", q.EventID, err.Error())
+	}
+
+	if price, ok := prices[closingRunnerKey{eventID: q.EventID, exchange: q.Exchange}]; ok {
+		return &price, nil
+	}
+
+	return nil, nil
+}
+
+// search returns the closing prices of every event kicking off at the same time as the query event. Searches are
+// cached if the finder was created using NewCachedClosingPriceFinder.
+func (c *closingPriceFinder) search(ctx context.Context, q *ClosingPriceQuery) (map[closingRunnerKey]float32, error) {
+	key := closingSearchKey{market: q.Market, runner: q.Runner, side: q.Side, eventDate: q.EventDate.Unix()}
+
+	c.lock.Lock()
+	prices, ok := c.searches[key]
+	c.lock.Unlock()
+
+	if ok {
+		return prices, nil
+	}
+
+	req := statistico.MarketRunnerRequest{
+		Market:   q.Market,
+		Runner:   q.Runner,
+		Line:     ClosingLine,
+		Side:     statistico.SideEnum(statistico.SideEnum_value[q.Side]),
+		DateFrom: timestamppb.New(q.EventDate.Add(-closingPriceWindow)),
+		DateTo:   timestamppb.New(q.EventDate.Add(closingPriceWindow)),
+	}
+
+	markets, errCh := c.marketClient.MarketRunnerSearch(ctx, &req, 100)
+
+	prices = map[closingRunnerKey]float32{}
+
+	for mk := range markets {
+		if mk.Price != nil {
+			prices[closingRunnerKey{eventID: mk.EventId, exchange: mk.Exchange}] = mk.Price.Value
+		}
+	}
+
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	if c.searches != nil {
+		c.searches[key] = prices
+	}
+	c.lock.Unlock()
+
+	return prices, nil
+}
+
+// ClosingLineValue measures entry prices against closing prices. CLV for a Back trade is the percentage by which
+// the entry price exceeds the closing price and for a Lay trade the percentage by which the closing price exceeds
+// the entry price, so positive CLV always means the trade beat the closing line. Trades without a closing price
+// are counted as Missing and excluded from every other measure.
+type ClosingLineValue struct {
+	Trades              int     `json:"trades"`
+	Missing             int     `json:"missing"`
+	BeatClosingLine     int     `json:"beatClosingLine"`
+	BeatClosingLineRate float64 `json:"beatClosingLineRate"`
+	AverageCLV          float64 `json:"averageClv"`
+}
+
+// Add includes a trade in the ClosingLineValue totals. A nil closing price is counted as missing.
+func (c *ClosingLineValue) Add(side string, price float32, closing *float32) {
+	if closing == nil || *closing <= 1 || price <= 1 {
+		c.Missing++
+		return
+	}
+
+	clv := CalculateClosingLineValue(side, price, *closing)
+
+	c.AverageCLV = (c.AverageCLV*float64(c.Trades) + clv) / float64(c.Trades+1)
+	c.Trades++
+
+	if clv > 0 {
+		c.BeatClosingLine++
+	}
+
+	c.BeatClosingLineRate = float64(c.BeatClosingLine) / float64(c.Trades) * 100
+}
+
+// CalculateClosingLineValue returns the percentage CLV of a trade placed at price against the closing price.
+func CalculateClosingLineValue(side string, price, closing float32) float64 {
+	if side == Lay {
+		return (float64(closing)/float64(price) - 1) * 100
+	}
+
+	return (float64(price)/float64(closing) - 1) * 100
+}
+
+func NewClosingPriceFinder(m statisticooddswarehouse.MarketClient) ClosingPriceFinder {
+	return &closingPriceFinder{marketClient: m}
+}
+
+// NewCachedClosingPriceFinder returns a ClosingPriceFinder that searches the odds warehouse once for every event
+// kicking off at the same time, so trades built for the same kickoff time share a single search. Searches are
+// never expired so it should only be used to find the closing prices of events that have kicked off.
+func NewCachedClosingPriceFinder(m statisticooddswarehouse.MarketClient) ClosingPriceFinder {
+	return &closingPriceFinder{marketClient: m, searches: map[closingSearchKey]map[closingRunnerKey]float32{}}
+}
@@ -0,0 +1,233 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestClosingPriceFinder_ClosingPrice(t *testing.T) {
+	date := time.Unix(1617126949, 0)
+
+	query := strategy.ClosingPriceQuery{
+		EventID:   1234,
+		EventDate: date,
+		Market:    "MATCH_ODDS",
+		Runner:    "Home",
+		Side:      "BACK",
+		Exchange:  "betfair",
+	}
+
+	newMarket := func(eventID uint64, exchange string, price float32) *statistico.MarketRunner {
+		return &statistico.MarketRunner{
+			MarketName: "MATCH_ODDS",
+			RunnerName: "Home",
+			EventId:    eventID,
+			EventDate:  timestamppb.New(date),
+			Exchange:   exchange,
+			Price:      &statistico.Price{Value: price},
+		}
+	}
+
+	t.Run("returns the closing price of the runner for the event and exchange", func(t *testing.T) {
+		t.Helper()
+
+		marketClient := new(MockMarketClient)
+		finder := strategy.NewClosingPriceFinder(marketClient)
+
+		ctx := context.Background()
+
+		req := mock.MatchedBy(func(r *statistico.MarketRunnerRequest) bool {
+			a := assert.New(t)
+
+			a.Equal("MATCH_ODDS", r.GetMarket())
+			a.Equal("Home", r.GetRunner())
+			a.Equal("CLOSING", r.GetLine())
+			a.Equal(statistico.SideEnum_BACK, r.GetSide())
+			a.Equal(date.Add(-time.Minute).Unix(), r.GetDateFrom().GetSeconds())
+			a.Equal(date.Add(time.Minute).Unix(), r.GetDateTo().GetSeconds())
+			return true
+		})
+
+		markets := []*statistico.MarketRunner{
+			newMarket(5678, "betfair", 1.70),
+			newMarket(1234, "smarkets", 1.90),
+			newMarket(1234, "betfair", 1.85),
+		}
+
+		marketClient.On("MarketRunnerSearch", ctx, req, 100).Return(marketChannel(markets), errChan(nil))
+
+		price, err := finder.ClosingPrice(ctx, &query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float32(1.85), *price)
+		marketClient.AssertExpectations(t)
+	})
+
+	t.Run("returns nil if the odds warehouse does not contain a closing price", func(t *testing.T) {
+		t.Helper()
+
+		marketClient := new(MockMarketClient)
+		finder := strategy.NewClosingPriceFinder(marketClient)
+
+		ctx := context.Background()
+
+		markets := []*statistico.MarketRunner{newMarket(5678, "betfair", 1.70)}
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 100).
+			Return(marketChannel(markets), errChan(nil))
+
+		price, err := finder.ClosingPrice(ctx, &query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, price)
+	})
+
+	t.Run("cached finder searches the odds warehouse once for events kicking off at the same time", func(t *testing.T) {
+		t.Helper()
+
+		marketClient := new(MockMarketClient)
+		finder := strategy.NewCachedClosingPriceFinder(marketClient)
+
+		ctx := context.Background()
+
+		markets := []*statistico.MarketRunner{
+			newMarket(5678, "betfair", 1.70),
+			newMarket(1234, "betfair", 1.85),
+		}
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 100).
+			Once().
+			Return(marketChannel(markets), errChan(nil))
+
+		other := query
+		other.EventID = 5678
+
+		first, err := finder.ClosingPrice(ctx, &query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		second, err := finder.ClosingPrice(ctx, &other)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float32(1.85), *first)
+		assert.Equal(t, float32(1.70), *second)
+		marketClient.AssertNumberOfCalls(t, "MarketRunnerSearch", 1)
+	})
+
+	t.Run("cached finder does not cache errors returned by market client", func(t *testing.T) {
+		t.Helper()
+
+		marketClient := new(MockMarketClient)
+		finder := strategy.NewCachedClosingPriceFinder(marketClient)
+
+		ctx := context.Background()
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 100).
+			Once().
+			Return(marketChannel([]*statistico.MarketRunner{}), errChan(errors.New("odds warehouse unavailable")))
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 100).
+			Once().
+			Return(marketChannel([]*statistico.MarketRunner{newMarket(1234, "betfair", 1.85)}), errChan(nil))
+
+		if _, err := finder.ClosingPrice(ctx, &query); err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		price, err := finder.ClosingPrice(ctx, &query)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float32(1.85), *price)
+		marketClient.AssertNumberOfCalls(t, "MarketRunnerSearch", 2)
+	})
+
+	t.Run("returns error if error returned by market client", func(t *testing.T) {
+		t.Helper()
+
+		marketClient := new(MockMarketClient)
+		finder := strategy.NewClosingPriceFinder(marketClient)
+
+		ctx := context.Background()
+
+		marketClient.On("MarketRunnerSearch", ctx, mock.AnythingOfType("*statistico.MarketRunnerRequest"), 100).
+			Return(marketChannel([]*statistico.MarketRunner{}), errChan(errors.New("odds warehouse unavailable")))
+
+		_, err := finder.ClosingPrice(ctx, &query)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "error fetching closing price for event 1234: odds warehouse unavailable", err.Error())
+	})
+}
+
+func TestClosingLineValue_Add(t *testing.T) {
+	t.Run("aggregates closing line value of trades", func(t *testing.T) {
+		t.Helper()
+
+		var clv strategy.ClosingLineValue
+
+		price := func(p float32) *float32 {
+			return &p
+		}
+
+		clv.Add("BACK", 2.20, price(2.00))
+		clv.Add("BACK", 1.80, price(2.00))
+		clv.Add("LAY", 2.50, price(2.75))
+		clv.Add("BACK", 2.00, nil)
+		clv.Add("BACK", 2.00, price(0))
+
+		a := assert.New(t)
+
+		a.Equal(3, clv.Trades)
+		a.Equal(2, clv.Missing)
+		a.Equal(2, clv.BeatClosingLine)
+		a.InDelta(66.6667, clv.BeatClosingLineRate, 0.0001)
+		a.InDelta(3.3333, clv.AverageCLV, 0.0001)
+	})
+}
+
+func TestCalculateClosingLineValue(t *testing.T) {
+	t.Run("returns positive CLV for trades that beat the closing line", func(t *testing.T) {
+		t.Helper()
+
+		tests := []struct {
+			Side     string
+			Price    float32
+			Closing  float32
+			Expected float64
+		}{
+			{"BACK", 2.20, 2.00, 10},
+			{"BACK", 1.80, 2.00, -10},
+			{"BACK", 2.00, 2.00, 0},
+			{"LAY", 2.00, 2.20, 10},
+			{"LAY", 2.50, 2.00, -20},
+		}
+
+		for _, tc := range tests {
+			clv := strategy.CalculateClosingLineValue(tc.Side, tc.Price, tc.Closing)
+			assert.InDelta(t, tc.Expected, clv, 0.0001)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package strategy
+
+// Commission contains the percentage of net winnings charged by each exchange and the percentage discount on
+// that rate given to individual users, keyed by exchange name and user ID respectively.
+type Commission struct {
+	Rates     map[string]float32
+	Discounts map[string]float32
+}
+
+// Rate returns the percentage commission rate charged to a user by an exchange after any discount. Exchanges
+// without a configured rate do not charge commission.
+func (c Commission) Rate(exchange, userID string) float32 {
+	rate := c.Rates[exchange]
+
+	return rate - (rate * c.Discounts[userID] / 100)
+}
+
+// CalculateNetProfit returns profit after deducting commission at the percentage rate provided. Commission is
+// only charged on winnings so losses are returned unchanged. It is applied to each trade's profit on its own,
+// whereas Betfair charges commission on the net profit of all bets in a market, so strategies placing several
+// trades in the same market will have their commission overstated.
+func CalculateNetProfit(profit, rate float32) float32 {
+	if profit <= 0 {
+		return profit
+	}
+
+	return profit - (profit * rate / 100)
+}
+
+// CalculateLiability returns the amount at risk on a trade, which is the stake for Back trades and the stake
+// multiplied by price minus one for Lay trades.
+func CalculateLiability(side string, price, stake float32) float32 {
+	if side == Lay {
+		return stake * (price - 1)
+	}
+
+	return stake
+}
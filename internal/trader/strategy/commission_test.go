@@ -0,0 +1,52 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCommission_Rate(t *testing.T) {
+	t.Run("returns the exchange rate after the user discount", func(t *testing.T) {
+		t.Helper()
+
+		c := strategy.Commission{
+			Rates:     map[string]float32{"betfair": 5, "smarkets": 2},
+			Discounts: map[string]float32{"user-1": 20},
+		}
+
+		tc := []struct {
+			Exchange string
+			UserID   string
+			Expected float32
+		}{
+			{Exchange: "betfair", UserID: "user-1", Expected: 4},
+			{Exchange: "betfair", UserID: "user-2", Expected: 5},
+			{Exchange: "smarkets", UserID: "user-1", Expected: 1.6},
+			{Exchange: "matchbook", UserID: "user-1", Expected: 0},
+		}
+
+		for _, tt := range tc {
+			assert.InDelta(t, tt.Expected, c.Rate(tt.Exchange, tt.UserID), 0.0001)
+		}
+	})
+}
+
+func TestCalculateNetProfit(t *testing.T) {
+	t.Run("deducts commission from winnings only", func(t *testing.T) {
+		t.Helper()
+
+		assert.InDelta(t, 9.5, strategy.CalculateNetProfit(10, 5), 0.0001)
+		assert.Equal(t, float32(-10), strategy.CalculateNetProfit(-10, 5))
+		assert.Equal(t, float32(0), strategy.CalculateNetProfit(0, 5))
+	})
+}
+
+func TestCalculateLiability(t *testing.T) {
+	t.Run("returns the stake for back trades and stake multiplied by price minus one for lay trades", func(t *testing.T) {
+		t.Helper()
+
+		assert.Equal(t, float32(10), strategy.CalculateLiability("BACK", 4.0, 10))
+		assert.Equal(t, float32(30), strategy.CalculateLiability("LAY", 4.0, 10))
+	})
+}
@@ -0,0 +1,97 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/statistico/statistico-data-go-grpc-client"
+)
+
+type ComparativeStatFilterClassifier interface {
+	MatchesFilter(ctx context.Context, fix *Fixture, f *ComparativeStatFilter) (bool, error)
+}
+
+type comparativeStatFilterClassifier struct {
+	fetcher *teamResultFetcher
+}
+
+// MatchesFilter calculates the Measure of Stat for the home and away teams of the Fixture and determines if the
+// comparison of the two values matches the provided ComparativeStatFilter. A filter is not matched if either team
+// has fewer than MinGames results, or fewer than Games results if MinGames is zero.
+func (c *comparativeStatFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *ComparativeStatFilter) (bool, error) {
+	home, ok, err := c.teamValue(ctx, fix, fix.HomeTeamID, f.HomeAction, f.HomeVenue, f)
+
+	if err != nil || !ok {
+		return false, err
+	}
+
+	away, ok, err := c.teamValue(ctx, fix, fix.AwayTeamID, f.AwayAction, f.AwayVenue, f)
+
+	if err != nil || !ok {
+		return false, err
+	}
+
+	switch f.Comparison {
+	case Difference:
+		return meetsMetric(home-away, f.Metric, f.Value)
+	case PercentageDifference:
+		if away == 0 {
+			return false, nil
+		}
+
+		return meetsMetric((home-away)/away*100, f.Metric, f.Value)
+	default:
+		return false, fmt.Errorf("comparison %s is not supported", f.Comparison)
+	}
+}
+
+// teamValue returns the calculated measure for a team and a bool indicating whether enough results were found to
+// calculate it.
+func (c *comparativeStatFilterClassifier) teamValue(
+	ctx context.Context,
+	fix *Fixture,
+	teamID uint64,
+	action,
+	venue string,
+	f *ComparativeStatFilter,
+) (float32, bool, error) {
+	results, err := c.fetcher.fetch(ctx, fix, teamID, f.Games, venue, f.Lookback)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(results) == 0 || !meetsMinimumGames(results, f.Games, f.MinGames) {
+		return 0, false, nil
+	}
+
+	sf := StatFilter{
+		Stat:      f.Stat,
+		Action:    action,
+		Measure:   f.Measure,
+		Metric:    f.ThresholdMetric,
+		Threshold: f.Threshold,
+		Decay:     f.Decay,
+	}
+
+	if sf.Metric == "" {
+		sf.Metric = Gte
+	}
+
+	values, err := parseStatValues(results, teamID, &sf)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	val, err := calculateMeasure(values, &sf)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	return val, true, nil
+}
+
+func NewComparativeStatFilterClassifier(r statisticodata.ResultClient, s statisticodata.SeasonClient) ComparativeStatFilterClassifier {
+	return &comparativeStatFilterClassifier{fetcher: &teamResultFetcher{resultClient: r, seasonClient: s}}
+}
@@ -0,0 +1,300 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestComparativeStatFilterClassifier_MatchesFilter(t *testing.T) {
+	fixture := strategy.Fixture{
+		ID:         55,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Date:       time.Unix(1584014400, 0),
+		SeasonID:   8,
+	}
+
+	// Home team goals for: 3, 2, 1 (average 2). Away team goals against: 1, 0, 2 (average 1)
+	homeResults := []*statistico.Result{
+		newProtoResult(1, 10, 3, 0),
+		newProtoResult(11, 1, 1, 2),
+		newProtoResult(1, 12, 1, 1),
+	}
+
+	awayResults := []*statistico.Result{
+		newProtoResult(2, 10, 2, 1),
+		newProtoResult(11, 2, 0, 3),
+		newProtoResult(2, 12, 0, 2),
+	}
+
+	mockClient := func(ctx context.Context, home, away []*statistico.Result) *m.ResultClient {
+		client := new(m.ResultClient)
+
+		homeReq := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+			return r.TeamId == 1 && r.GetVenue().GetValue() == "HOME"
+		})
+
+		awayReq := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+			return r.TeamId == 2 && r.GetVenue().GetValue() == "AWAY"
+		})
+
+		client.On("ByTeam", ctx, homeReq).Return(home, nil)
+		client.On("ByTeam", ctx, awayReq).Return(away, nil)
+
+		return client
+	}
+
+	t.Run("returns bool if comparison of home and away stats matches filter", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			Filter   *strategy.ComparativeStatFilter
+			Expected bool
+		}{
+			{
+				Filter: &strategy.ComparativeStatFilter{
+					Stat:       "GOALS",
+					HomeAction: "FOR",
+					HomeVenue:  "HOME",
+					AwayAction: "AGAINST",
+					AwayVenue:  "AWAY",
+					Games:      3,
+					Measure:    "AVERAGE",
+					Comparison: "DIFFERENCE",
+					Metric:     "GTE",
+					Value:      0.8,
+				},
+				Expected: true,
+			},
+			{
+				Filter: &strategy.ComparativeStatFilter{
+					Stat:       "GOALS",
+					HomeAction: "FOR",
+					HomeVenue:  "HOME",
+					AwayAction: "AGAINST",
+					AwayVenue:  "AWAY",
+					Games:      3,
+					Measure:    "AVERAGE",
+					Comparison: "DIFFERENCE",
+					Metric:     "GTE",
+					Value:      1.2,
+				},
+				Expected: false,
+			},
+			{
+				Filter: &strategy.ComparativeStatFilter{
+					Stat:       "GOALS",
+					HomeAction: "FOR",
+					HomeVenue:  "HOME",
+					AwayAction: "AGAINST",
+					AwayVenue:  "AWAY",
+					Games:      3,
+					Measure:    "TOTAL",
+					Comparison: "PERCENTAGE_DIFFERENCE",
+					Metric:     "GTE",
+					Value:      100,
+				},
+				Expected: true,
+			},
+			{
+				Filter: &strategy.ComparativeStatFilter{
+					Stat:       "GOALS",
+					HomeAction: "FOR",
+					HomeVenue:  "HOME",
+					AwayAction: "FOR",
+					AwayVenue:  "AWAY",
+					Games:      3,
+					Measure:    "AVERAGE",
+					Comparison: "PERCENTAGE_DIFFERENCE",
+					Metric:     "LTE",
+					Value:      -30,
+				},
+				Expected: false,
+			},
+		}
+
+		for index, a := range assertions {
+			ctx := context.Background()
+			client := mockClient(ctx, homeResults, awayResults)
+			classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+			success, err := classifier.MatchesFilter(ctx, &fixture, a.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), index)
+			}
+
+			assert.Equal(t, a.Expected, success, "index %d", index)
+		}
+	})
+
+	t.Run("returns false if a team has fewer results than minimum games", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := mockClient(ctx, homeResults, awayResults[:1])
+		classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+		f := strategy.ComparativeStatFilter{
+			Stat:       "GOALS",
+			HomeAction: "FOR",
+			HomeVenue:  "HOME",
+			AwayAction: "AGAINST",
+			AwayVenue:  "AWAY",
+			Games:      3,
+			MinGames:   3,
+			Measure:    "AVERAGE",
+			Comparison: "DIFFERENCE",
+			Metric:     "GTE",
+			Value:      -10,
+		}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("applies threshold metric to each game and metric to the comparison for the percentage measure", func(t *testing.T) {
+		t.Helper()
+
+		// Home team games with 2 or more goals for: 66.67%. Away team games with 2 or more goals against: 33.33%
+		assertions := []struct {
+			ThresholdMetric string
+			Expected        bool
+		}{
+			{ThresholdMetric: "", Expected: false},
+			{ThresholdMetric: "GTE", Expected: false},
+			{ThresholdMetric: "LTE", Expected: true},
+		}
+
+		for i, a := range assertions {
+			ctx := context.Background()
+			client := mockClient(ctx, homeResults, awayResults)
+			classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+			f := strategy.ComparativeStatFilter{
+				Stat:            "GOALS",
+				HomeAction:      "FOR",
+				HomeVenue:       "HOME",
+				AwayAction:      "AGAINST",
+				AwayVenue:       "AWAY",
+				Games:           3,
+				Measure:         "PERCENTAGE",
+				ThresholdMetric: a.ThresholdMetric,
+				Threshold:       2,
+				Comparison:      "DIFFERENCE",
+				Metric:          "LTE",
+				Value:           0,
+			}
+
+			success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, a.Expected, success, "index %d", i)
+		}
+	})
+
+	t.Run("returns false if percentage difference is calculated against zero", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := mockClient(ctx, homeResults, []*statistico.Result{newProtoResult(2, 10, 0, 0)})
+		classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+		f := strategy.ComparativeStatFilter{
+			Stat:       "GOALS",
+			HomeAction: "FOR",
+			HomeVenue:  "HOME",
+			AwayAction: "FOR",
+			AwayVenue:  "AWAY",
+			Games:      3,
+			Measure:    "AVERAGE",
+			Comparison: "PERCENTAGE_DIFFERENCE",
+			Metric:     "GTE",
+			Value:      30,
+		}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("returns error if comparison is not supported", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := mockClient(ctx, homeResults, awayResults)
+		classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+		f := strategy.ComparativeStatFilter{
+			Stat:       "GOALS",
+			HomeAction: "FOR",
+			HomeVenue:  "HOME",
+			AwayAction: "FOR",
+			AwayVenue:  "AWAY",
+			Games:      3,
+			Measure:    "AVERAGE",
+			Comparison: "RATIO",
+			Metric:     "GTE",
+			Value:      2,
+		}
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "comparison RATIO is not supported", err.Error())
+	})
+
+	t.Run("returns error if returned by result client", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := new(m.ResultClient)
+		classifier := strategy.NewComparativeStatFilterClassifier(client, new(m.SeasonClient))
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{}, errors.New("oh no"))
+
+		f := strategy.ComparativeStatFilter{
+			Stat:       "GOALS",
+			HomeAction: "FOR",
+			HomeVenue:  "HOME",
+			AwayAction: "FOR",
+			AwayVenue:  "AWAY",
+			Games:      3,
+			Measure:    "AVERAGE",
+			Comparison: "DIFFERENCE",
+			Metric:     "GTE",
+			Value:      2,
+		}
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+	})
+}
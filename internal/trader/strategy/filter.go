@@ -2,6 +2,7 @@ package strategy
 
 import (
 	"context"
+	"fmt"
 	"github.com/statistico/statistico-data-go-grpc-client"
 	"time"
 )
@@ -11,13 +12,21 @@ type FilterMatcher interface {
 }
 
 type filterMatcher struct {
-	fixtureClient    statisticodata.FixtureClient
-	resultClassifier ResultFilterClassifier
-	statClassifier   StatFilterClassifier
+	fixtureClient         statisticodata.FixtureClient
+	resultClassifier      ResultFilterClassifier
+	statClassifier        StatFilterClassifier
+	h2hClassifier         HeadToHeadFilterClassifier
+	tableClassifier       LeagueTableFilterClassifier
+	comparativeClassifier ComparativeStatFilterClassifier
+	scheduleClassifier    ScheduleFilterClassifier
 }
 
-// MatchesFilters receives a MatcherQuery containing trader.ResultFilter and trader.StatFilter slices and determines if
-// Fixture matching EventID matches all filters provided.
+// condition is a lazily evaluated filter check, allowing a FilterGroup to skip data service calls once the
+// outcome of the group is known.
+type condition func() (bool, error)
+
+// MatchesFilters receives a MatcherQuery containing filter and trader.FilterGroup slices and determines if Fixture
+// matching EventID matches all filters and filter groups provided.
 func (f *filterMatcher) MatchesFilters(ctx context.Context, q *MatcherQuery) (bool, error) {
 	fixture, err := f.fixtureClient.ByID(ctx, q.EventID)
 
@@ -26,27 +35,115 @@ func (f *filterMatcher) MatchesFilters(ctx context.Context, q *MatcherQuery) (bo
 	}
 
 	fix := Fixture{
-		ID:         uint64(fixture.Id),
-		HomeTeamID: fixture.HomeTeam.Id,
-		AwayTeamID: fixture.AwayTeam.Id,
-		Date:       time.Unix(fixture.DateTime.Utc, 0),
-		SeasonID:   fixture.Season.Id,
+		ID:            uint64(fixture.Id),
+		HomeTeamID:    fixture.HomeTeam.Id,
+		AwayTeamID:    fixture.AwayTeam.Id,
+		Date:          time.Unix(fixture.DateTime.Utc, 0),
+		CompetitionID: fixture.GetCompetition().GetId(),
+		SeasonID:      fixture.Season.Id,
+	}
+
+	group := FilterGroup{
+		Operator:               And,
+		ResultFilters:          q.ResultFilters,
+		StatFilters:            q.StatFilters,
+		HeadToHeadFilters:      q.HeadToHeadFilters,
+		LeagueTableFilters:     q.LeagueTableFilters,
+		ComparativeStatFilters: q.ComparativeStatFilters,
+		ScheduleFilters:        q.ScheduleFilters,
+		Groups:                 q.FilterGroups,
 	}
 
-	for _, filter := range q.ResultFilters {
-		success, err := f.resultClassifier.MatchesFilter(ctx, &fix, filter)
+	return f.matchesGroup(ctx, &fix, &group)
+}
+
+func (f *filterMatcher) matchesGroup(ctx context.Context, fix *Fixture, g *FilterGroup) (bool, error) {
+	conditions := f.groupConditions(ctx, fix, g)
+
+	switch g.Operator {
+	case And:
+		return matchesAll(conditions)
+	case Or:
+		return matchesAny(conditions)
+	case Not:
+		matches, err := matchesAll(conditions)
 
 		if err != nil {
 			return false, err
 		}
 
-		if !success {
-			return false, nil
-		}
+		return !matches, nil
+	default:
+		return false, fmt.Errorf("filter group operator %s is not supported", g.Operator)
+	}
+}
+
+func (f *filterMatcher) groupConditions(ctx context.Context, fix *Fixture, g *FilterGroup) []condition {
+	var conditions []condition
+
+	for _, filter := range g.ResultFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.resultClassifier.MatchesFilter(ctx, fix, filter)
+		})
+	}
+
+	for _, filter := range g.StatFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.statClassifier.MatchesFilter(ctx, fix, filter)
+		})
+	}
+
+	for _, filter := range g.HeadToHeadFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.h2hClassifier.MatchesFilter(ctx, fix, filter)
+		})
+	}
+
+	for _, filter := range g.LeagueTableFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.tableClassifier.MatchesFilter(ctx, fix, filter)
+		})
 	}
 
-	for _, filter := range q.StatFilters {
-		success, err := f.statClassifier.MatchesFilter(ctx, &fix, filter)
+	for _, filter := range g.ComparativeStatFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.comparativeClassifier.MatchesFilter(ctx, fix, filter)
+		})
+	}
+
+	for _, filter := range g.ScheduleFilters {
+		filter := filter
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.scheduleClassifier.MatchesFilter(ctx, fix, filter)
+		})
+	}
+
+	for _, group := range g.Groups {
+		group := group
+
+		conditions = append(conditions, func() (bool, error) {
+			return f.matchesGroup(ctx, fix, group)
+		})
+	}
+
+	return conditions
+}
+
+// matchesAll returns false as soon as a condition is not met without evaluating the remaining conditions.
+func matchesAll(conditions []condition) (bool, error) {
+	for _, c := range conditions {
+		success, err := c()
 
 		if err != nil {
 			return false, err
@@ -60,6 +157,39 @@ func (f *filterMatcher) MatchesFilters(ctx context.Context, q *MatcherQuery) (bo
 	return true, nil
 }
 
-func NewFilterMatcher(f statisticodata.FixtureClient, r ResultFilterClassifier, s StatFilterClassifier) FilterMatcher {
-	return &filterMatcher{fixtureClient: f, resultClassifier: r, statClassifier: s}
+// matchesAny returns true as soon as a condition is met without evaluating the remaining conditions.
+func matchesAny(conditions []condition) (bool, error) {
+	for _, c := range conditions {
+		success, err := c()
+
+		if err != nil {
+			return false, err
+		}
+
+		if success {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func NewFilterMatcher(
+	f statisticodata.FixtureClient,
+	r ResultFilterClassifier,
+	s StatFilterClassifier,
+	h HeadToHeadFilterClassifier,
+	l LeagueTableFilterClassifier,
+	c ComparativeStatFilterClassifier,
+	sc ScheduleFilterClassifier,
+) FilterMatcher {
+	return &filterMatcher{
+		fixtureClient:         f,
+		resultClassifier:      r,
+		statClassifier:        s,
+		h2hClassifier:         h,
+		tableClassifier:       l,
+		comparativeClassifier: c,
+		scheduleClassifier:    sc,
+	}
 }
@@ -70,11 +70,12 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 	}
 
 	fix := strategy.Fixture{
-		ID:         192810,
-		HomeTeamID: 5,
-		AwayTeamID: 10,
-		Date:       time.Unix(1616052304, 0),
-		SeasonID:   17420,
+		ID:            192810,
+		HomeTeamID:    5,
+		AwayTeamID:    10,
+		Date:          time.Unix(1616052304, 0),
+		CompetitionID: 8,
+		SeasonID:      17420,
 	}
 
 	t.Run("returns bool if Fixture matches all filters provided", func(t *testing.T) {
@@ -83,6 +84,10 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		fc := new(mock2.FixtureClient)
 		rc := new(MockResultClassifier)
 		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
 
 		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
 
@@ -92,7 +97,7 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		sc.On("MatchesFilter", ctx, &fix, f3).Return(true, nil)
 		sc.On("MatchesFilter", ctx, &fix, f4).Return(true, nil)
 
-		matcher := strategy.NewFilterMatcher(fc, rc, sc)
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
 
 		matches, err := matcher.MatchesFilters(ctx, &query)
 
@@ -111,6 +116,10 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		fc := new(mock2.FixtureClient)
 		rc := new(MockResultClassifier)
 		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
 
 		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
 
@@ -119,7 +128,7 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 
 		sc.AssertNotCalled(t, "MatchesFilter")
 
-		matcher := strategy.NewFilterMatcher(fc, rc, sc)
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
 
 		matches, err := matcher.MatchesFilters(ctx, &query)
 
@@ -138,6 +147,10 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		fc := new(mock2.FixtureClient)
 		rc := new(MockResultClassifier)
 		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
 
 		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
 
@@ -147,7 +160,7 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		sc.On("MatchesFilter", ctx, &fix, f3).Return(true, nil)
 		sc.On("MatchesFilter", ctx, &fix, f4).Return(false, nil)
 
-		matcher := strategy.NewFilterMatcher(fc, rc, sc)
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
 
 		matches, err := matcher.MatchesFilters(ctx, &query)
 
@@ -166,6 +179,10 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		fc := new(mock2.FixtureClient)
 		rc := new(MockResultClassifier)
 		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
 
 		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
 
@@ -176,7 +193,7 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 
 		sc.AssertNotCalled(t, "MatchesFilter")
 
-		matcher := strategy.NewFilterMatcher(fc, rc, sc)
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
 
 		_, err := matcher.MatchesFilters(ctx, &query)
 
@@ -195,6 +212,10 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		fc := new(mock2.FixtureClient)
 		rc := new(MockResultClassifier)
 		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
 
 		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
 
@@ -206,7 +227,7 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		sc.On("MatchesFilter", ctx, &fix, f3).Return(true, nil)
 		sc.On("MatchesFilter", ctx, &fix, f4).Return(false, e)
 
-		matcher := strategy.NewFilterMatcher(fc, rc, sc)
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
 
 		_, err := matcher.MatchesFilters(ctx, &query)
 
@@ -218,6 +239,172 @@ func TestFilterMatcher_MatchesFilters(t *testing.T) {
 		rc.AssertExpectations(t)
 		sc.AssertExpectations(t)
 	})
+
+	t.Run("OR filter group returns true without evaluating remaining filters once a filter matches", func(t *testing.T) {
+		t.Helper()
+
+		fc := new(mock2.FixtureClient)
+		rc := new(MockResultClassifier)
+		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
+
+		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
+
+		rc.On("MatchesFilter", ctx, &fix, f1).Return(false, nil)
+		rc.On("MatchesFilter", ctx, &fix, f2).Return(true, nil)
+
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
+
+		q := strategy.MatcherQuery{
+			EventID: 192810,
+			FilterGroups: []*strategy.FilterGroup{
+				{
+					Operator:      "OR",
+					ResultFilters: []*strategy.ResultFilter{f1, f2},
+					StatFilters:   []*strategy.StatFilter{f3},
+				},
+			},
+		}
+
+		matches, err := matcher.MatchesFilters(ctx, &q)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, matches)
+		rc.AssertExpectations(t)
+		sc.AssertNotCalled(t, "MatchesFilter", ctx, &fix, f3)
+	})
+
+	t.Run("OR filter group returns false if no filters or nested groups match", func(t *testing.T) {
+		t.Helper()
+
+		fc := new(mock2.FixtureClient)
+		rc := new(MockResultClassifier)
+		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
+
+		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
+
+		rc.On("MatchesFilter", ctx, &fix, f1).Return(false, nil)
+		rc.On("MatchesFilter", ctx, &fix, f2).Return(true, nil)
+
+		sc.On("MatchesFilter", ctx, &fix, f3).Return(true, nil)
+		sc.On("MatchesFilter", ctx, &fix, f4).Return(false, nil)
+
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
+
+		q := strategy.MatcherQuery{
+			EventID: 192810,
+			FilterGroups: []*strategy.FilterGroup{
+				{
+					Operator:      "OR",
+					ResultFilters: []*strategy.ResultFilter{f1},
+					Groups: []*strategy.FilterGroup{
+						{
+							Operator:      "AND",
+							ResultFilters: []*strategy.ResultFilter{f2},
+							StatFilters:   []*strategy.StatFilter{f3, f4},
+						},
+					},
+				},
+			},
+		}
+
+		matches, err := matcher.MatchesFilters(ctx, &q)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, matches)
+		rc.AssertExpectations(t)
+		sc.AssertExpectations(t)
+	})
+
+	t.Run("NOT filter group negates the combined result of its filters", func(t *testing.T) {
+		t.Helper()
+
+		fc := new(mock2.FixtureClient)
+		rc := new(MockResultClassifier)
+		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
+
+		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
+
+		rc.On("MatchesFilter", ctx, &fix, f1).Return(true, nil)
+
+		sc.On("MatchesFilter", ctx, &fix, f3).Return(false, nil)
+
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
+
+		q := strategy.MatcherQuery{
+			EventID:       192810,
+			ResultFilters: []*strategy.ResultFilter{f1},
+			FilterGroups: []*strategy.FilterGroup{
+				{
+					Operator:    "NOT",
+					StatFilters: []*strategy.StatFilter{f3, f4},
+				},
+			},
+		}
+
+		matches, err := matcher.MatchesFilters(ctx, &q)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, matches)
+		rc.AssertExpectations(t)
+		sc.AssertExpectations(t)
+		sc.AssertNotCalled(t, "MatchesFilter", ctx, &fix, f4)
+	})
+
+	t.Run("returns error if filter group operator is not supported", func(t *testing.T) {
+		t.Helper()
+
+		fc := new(mock2.FixtureClient)
+		rc := new(MockResultClassifier)
+		sc := new(MockStatClassifier)
+		hc := new(MockHeadToHeadClassifier)
+		lc := new(MockLeagueTableClassifier)
+		cc := new(MockComparativeStatClassifier)
+		scc := new(MockScheduleClassifier)
+
+		fc.On("ByID", ctx, uint64(192810)).Return(&fixture, nil)
+
+		matcher := strategy.NewFilterMatcher(fc, rc, sc, hc, lc, cc, scc)
+
+		q := strategy.MatcherQuery{
+			EventID: 192810,
+			FilterGroups: []*strategy.FilterGroup{
+				{
+					Operator:      "XOR",
+					ResultFilters: []*strategy.ResultFilter{f1},
+				},
+			},
+		}
+
+		_, err := matcher.MatchesFilters(ctx, &q)
+
+		if err == nil {
+			t.Fatal("Expected error got nil")
+		}
+
+		assert.Equal(t, "filter group operator XOR is not supported", err.Error())
+		rc.AssertNotCalled(t, "MatchesFilter", ctx, &fix, f1)
+	})
 }
 
 type MockResultClassifier struct {
@@ -237,3 +424,39 @@ func (m *MockStatClassifier) MatchesFilter(ctx context.Context, fix *strategy.Fi
 	args := m.Called(ctx, fix, f)
 	return args.Get(0).(bool), args.Error(1)
 }
+
+type MockHeadToHeadClassifier struct {
+	mock.Mock
+}
+
+func (m *MockHeadToHeadClassifier) MatchesFilter(ctx context.Context, fix *strategy.Fixture, f *strategy.HeadToHeadFilter) (bool, error) {
+	args := m.Called(ctx, fix, f)
+	return args.Get(0).(bool), args.Error(1)
+}
+
+type MockLeagueTableClassifier struct {
+	mock.Mock
+}
+
+func (m *MockLeagueTableClassifier) MatchesFilter(ctx context.Context, fix *strategy.Fixture, f *strategy.LeagueTableFilter) (bool, error) {
+	args := m.Called(ctx, fix, f)
+	return args.Get(0).(bool), args.Error(1)
+}
+
+type MockComparativeStatClassifier struct {
+	mock.Mock
+}
+
+func (m *MockComparativeStatClassifier) MatchesFilter(ctx context.Context, fix *strategy.Fixture, f *strategy.ComparativeStatFilter) (bool, error) {
+	args := m.Called(ctx, fix, f)
+	return args.Get(0).(bool), args.Error(1)
+}
+
+type MockScheduleClassifier struct {
+	mock.Mock
+}
+
+func (m *MockScheduleClassifier) MatchesFilter(ctx context.Context, fix *strategy.Fixture, f *strategy.ScheduleFilter) (bool, error) {
+	args := m.Called(ctx, fix, f)
+	return args.Get(0).(bool), args.Error(1)
+}
@@ -43,17 +43,35 @@ func (h *finder) findStrategies(ctx context.Context, q *FinderQuery, ch chan<- *
 
 	for _, s := range st {
 		wg.Add(1)
-		go h.filterStrategy(ctx, s, q.EventID, ch, &wg)
+		go h.filterStrategy(ctx, s, q, ch, &wg)
 	}
 
 	wg.Wait()
 }
 
-func (h *finder) filterStrategy(ctx context.Context, s *Strategy, eventID uint64, ch chan<- *Strategy, wg *sync.WaitGroup) {
+func (h *finder) filterStrategy(ctx context.Context, s *Strategy, q *FinderQuery, ch chan<- *Strategy, wg *sync.WaitGroup) {
+	allowed, err := s.TradeWindow.Allows(q.EventDate, q.Timestamp)
+
+	if err != nil {
+		h.logger.Errorf("error applying trade window for strategy %s: %+v", s.ID.String(), err)
+		wg.Done()
+		return
+	}
+
+	if !allowed {
+		wg.Done()
+		return
+	}
+
 	query := MatcherQuery{
-		EventID:       eventID,
-		ResultFilters: s.ResultFilters,
-		StatFilters:   s.StatFilters,
+		EventID:                q.EventID,
+		ResultFilters:          s.ResultFilters,
+		StatFilters:            s.StatFilters,
+		HeadToHeadFilters:      s.HeadToHeadFilters,
+		LeagueTableFilters:     s.LeagueTableFilters,
+		ComparativeStatFilters: s.ComparativeStatFilters,
+		ScheduleFilters:        s.ScheduleFilters,
+		FilterGroups:           s.FilterGroups,
 	}
 
 	matches, err := h.matcher.MatchesFilters(ctx, &query)
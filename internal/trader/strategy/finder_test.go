@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 )
 
 func TestFinder_FindMatchingStrategies(t *testing.T) {
@@ -131,6 +132,48 @@ func TestFinder_FindMatchingStrategies(t *testing.T) {
 		assert.Equal(t, 0, len(ch))
 	})
 
+	t.Run("does not push strategy into channel if outside of strategy trade window", func(t *testing.T) {
+		t.Helper()
+
+		reader := new(MockStrategyReader)
+		matcher := new(MockFilterMatcher)
+		logger, hook := test.NewNullLogger()
+
+		finder := strategy.NewFinder(reader, matcher, logger)
+
+		ctx := context.Background()
+
+		query := strategy.FinderQuery{
+			MarketName:    "MATCH_ODDS",
+			RunnerName:    "Home",
+			EventID:       1234,
+			CompetitionID: 8,
+			Price:         1.95,
+			Side:          "BACK",
+			Status:        "ACTIVE",
+			EventDate:     time.Unix(1616936400, 0),
+			Timestamp:     time.Unix(1616936400, 0).Add(-3 * time.Hour),
+		}
+
+		max := uint32(60)
+
+		stOne := &strategy.Strategy{
+			ID:          uuid.New(),
+			Name:        "Strategy One",
+			TradeWindow: strategy.TradeWindow{MaxMinutesBeforeKickoff: &max},
+		}
+
+		reader.On("Get", mock.AnythingOfType("*strategy.ReaderQuery")).Return([]*strategy.Strategy{stOne}, nil)
+
+		ch := finder.FindMatchingStrategies(ctx, &query)
+
+		fetched := <-ch
+
+		assert.Nil(t, fetched)
+		assert.Equal(t, 0, len(hook.AllEntries()))
+		matcher.AssertNotCalled(t, "MatchesFilters", mock.Anything, mock.Anything)
+	})
+
 	t.Run("error is logged if returned by strategy.Reader", func(t *testing.T) {
 		t.Helper()
 
@@ -0,0 +1,50 @@
+package strategy
+
+import (
+	"fmt"
+	"time"
+)
+
+// FixtureStatus records a fixture that did not finish normally. POSTPONED and ABANDONED fixtures settle as VOID and
+// AWARDED fixtures settle using the official HomeScore and AwayScore.
+type FixtureStatus struct {
+	FixtureID uint64    `json:"fixtureId"`
+	Status    string    `json:"status"`
+	HomeScore *uint32   `json:"homeScore"`
+	AwayScore *uint32   `json:"awayScore"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// parseStatusResult settles a market for a fixture with a FixtureStatus. Markets that cannot be settled from the
+// official score of an awarded fixture, such as half time and corners markets, are VOID.
+func parseStatusResult(s *FixtureStatus, market, runner string) (Result, error) {
+	switch s.Status {
+	case Postponed, Abandoned:
+		return Void, nil
+	case Awarded:
+		if s.HomeScore == nil || s.AwayScore == nil {
+			return Fail, fmt.Errorf("awarded fixture %d does not have an official score", s.FixtureID)
+		}
+
+		if !settledByFinalScore(market) {
+			return Void, nil
+		}
+
+		return parseResult(market, runner, *s.HomeScore, *s.AwayScore)
+	default:
+		return Fail, fmt.Errorf("fixture status %s is not supported", s.Status)
+	}
+}
+
+func settledByFinalScore(market string) bool {
+	if stat, _, ok := parseOverUnderMarket(market); ok {
+		return stat == Goals
+	}
+
+	switch market {
+	case HalfTime, HalfTimeFullTime, FirstHalfGoals05, FirstHalfGoals15, FirstHalfGoals25:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,114 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"time"
+)
+
+type HeadToHeadFilterClassifier interface {
+	MatchesFilter(ctx context.Context, fix *Fixture, f *HeadToHeadFilter) (bool, error)
+}
+
+type headToHeadFilterClassifier struct {
+	resultClient statisticodata.ResultClient
+}
+
+// MatchesFilter determines if the previous meetings between the Fixture home and away teams match the provided
+// HeadToHeadFilter. A filter is not matched if the teams have not met before the Fixture date.
+func (h *headToHeadFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *HeadToHeadFilter) (bool, error) {
+	if f.Result == "" && f.Stat == "" {
+		return false, fmt.Errorf("head to head filter requires either a result or stat")
+	}
+
+	teamID, err := parseTeamID(fix, f.Team)
+
+	if err != nil {
+		return false, err
+	}
+
+	opponentID, err := parseOpponentID(fix, f.Team)
+
+	if err != nil {
+		return false, err
+	}
+
+	req := statistico.TeamResultRequest{
+		TeamId:     teamID,
+		DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
+		Venue:      &wrappers.StringValue{Value: f.Venue},
+	}
+
+	results, err := h.resultClient.ByTeam(ctx, &req)
+
+	if err != nil {
+		return false, err
+	}
+
+	meetings := headToHeadResults(results, opponentID, f.Games)
+
+	if len(meetings) == 0 {
+		return false, nil
+	}
+
+	if f.Result != "" {
+		for _, res := range meetings {
+			if !resultMeetsCriteria(res, teamID, f.Result) {
+				return false, nil
+			}
+		}
+	}
+
+	if f.Stat != "" {
+		sf := StatFilter{
+			Stat:      f.Stat,
+			Action:    f.Action,
+			Measure:   f.Measure,
+			Metric:    f.Metric,
+			Value:     f.Value,
+			Threshold: f.Threshold,
+			Decay:     f.Decay,
+		}
+
+		return statMeetsCriteria(meetings, teamID, &sf)
+	}
+
+	return true, nil
+}
+
+func parseOpponentID(fix *Fixture, team string) (uint64, error) {
+	if team == HomeTeam {
+		return fix.AwayTeamID, nil
+	}
+
+	if team == AwayTeam {
+		return fix.HomeTeamID, nil
+	}
+
+	return 0, fmt.Errorf("team enum %s is not supported", team)
+}
+
+// headToHeadResults returns up to the number of games provided of the results played against the opponent,
+// preserving the order returned by the data service.
+func headToHeadResults(rs []*statistico.Result, opponentID uint64, games uint8) []*statistico.Result {
+	var meetings []*statistico.Result
+
+	for _, res := range rs {
+		if len(meetings) == int(games) {
+			break
+		}
+
+		if res.GetHomeTeam().GetId() == opponentID || res.GetAwayTeam().GetId() == opponentID {
+			meetings = append(meetings, res)
+		}
+	}
+
+	return meetings
+}
+
+func NewHeadToHeadFilterClassifier(c statisticodata.ResultClient) HeadToHeadFilterClassifier {
+	return &headToHeadFilterClassifier{resultClient: c}
+}
@@ -0,0 +1,182 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestHeadToHeadFilterClassifier_MatchesFilter(t *testing.T) {
+	fixture := strategy.Fixture{
+		ID:         55,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Date:       time.Unix(1584014400, 0),
+		SeasonID:   8,
+	}
+
+	t.Run("returns bool if previous meetings match head to head filter", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			FetchedResults []*statistico.Result
+			Filter         *strategy.HeadToHeadFilter
+			Expected       bool
+		}{
+			{
+				FetchedResults: []*statistico.Result{
+					newProtoResult(1, 50, 0, 1),
+					newProtoResult(1, 2, 3, 1),
+					newProtoResult(2, 1, 0, 0),
+					newProtoResult(1, 2, 0, 2),
+				},
+				Filter: &strategy.HeadToHeadFilter{
+					Team:   "HOME_TEAM",
+					Games:  2,
+					Venue:  "HOME_AWAY",
+					Result: "WIN_DRAW",
+				},
+				Expected: true,
+			},
+			{
+				FetchedResults: []*statistico.Result{
+					newProtoResult(1, 50, 0, 1),
+					newProtoResult(1, 2, 3, 1),
+					newProtoResult(2, 1, 0, 0),
+					newProtoResult(1, 2, 0, 2),
+				},
+				Filter: &strategy.HeadToHeadFilter{
+					Team:   "HOME_TEAM",
+					Games:  3,
+					Venue:  "HOME_AWAY",
+					Result: "WIN_DRAW",
+				},
+				Expected: false,
+			},
+			{
+				FetchedResults: []*statistico.Result{
+					newProtoResult(2, 1, 2, 1),
+					newProtoResult(2, 10, 3, 1),
+					newProtoResult(2, 1, 1, 1),
+				},
+				Filter: &strategy.HeadToHeadFilter{
+					Team:    "AWAY_TEAM",
+					Games:   2,
+					Venue:   "HOME",
+					Stat:    "GOALS",
+					Action:  "FOR",
+					Measure: "AVERAGE",
+					Metric:  "GTE",
+					Value:   1.5,
+				},
+				Expected: true,
+			},
+			{
+				FetchedResults: []*statistico.Result{
+					newProtoResult(2, 1, 2, 1),
+					newProtoResult(2, 1, 1, 1),
+				},
+				Filter: &strategy.HeadToHeadFilter{
+					Team:    "AWAY_TEAM",
+					Games:   2,
+					Venue:   "HOME",
+					Result:  "WIN",
+					Stat:    "GOALS",
+					Action:  "FOR",
+					Measure: "AVERAGE",
+					Metric:  "GTE",
+					Value:   1.5,
+				},
+				Expected: false,
+			},
+			{
+				FetchedResults: []*statistico.Result{
+					newProtoResult(1, 50, 0, 1),
+					newProtoResult(10, 1, 3, 1),
+				},
+				Filter: &strategy.HeadToHeadFilter{
+					Team:   "HOME_TEAM",
+					Games:  3,
+					Venue:  "HOME_AWAY",
+					Result: "WIN",
+				},
+				Expected: false,
+			},
+		}
+
+		for index, res := range assertions {
+			client := new(m.ResultClient)
+			classifier := strategy.NewHeadToHeadFilterClassifier(client)
+
+			ctx := context.Background()
+
+			teamID, _ := map[string]uint64{"HOME_TEAM": 1, "AWAY_TEAM": 2}[res.Filter.Team]
+
+			req := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+				a := assert.New(t)
+				a.Equal(teamID, r.TeamId)
+				a.Nil(r.SeasonIds)
+				a.Nil(r.Limit)
+				a.Equal(fixture.Date.Format(time.RFC3339), r.GetDateBefore().GetValue())
+				a.Equal(res.Filter.Venue, r.GetVenue().GetValue())
+				return true
+			})
+
+			client.On("ByTeam", ctx, req).Return(res.FetchedResults, nil)
+
+			success, err := classifier.MatchesFilter(ctx, &fixture, res.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), index)
+			}
+
+			assert.Equal(t, res.Expected, success, "index %d", index)
+		}
+	})
+
+	t.Run("returns error if filter does not contain a result or stat", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewHeadToHeadFilterClassifier(client)
+
+		f := strategy.HeadToHeadFilter{Team: "HOME_TEAM", Games: 3, Venue: "HOME_AWAY"}
+
+		_, err := classifier.MatchesFilter(context.Background(), &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "head to head filter requires either a result or stat", err.Error())
+		client.AssertNotCalled(t, "ByTeam")
+	})
+
+	t.Run("returns error if returned by result client", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewHeadToHeadFilterClassifier(client)
+
+		ctx := context.Background()
+
+		f := strategy.HeadToHeadFilter{Team: "HOME_TEAM", Games: 3, Venue: "HOME_AWAY", Result: "WIN"}
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{}, errors.New("oh no"))
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+	})
+}
@@ -0,0 +1,246 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"sort"
+	"sync"
+	"time"
+)
+
+// leagueTableCacheTTL is how long the results of a season are reused for fixtures dated after they were fetched.
+const leagueTableCacheTTL = time.Hour
+
+type LeagueTableFilterClassifier interface {
+	MatchesFilter(ctx context.Context, fix *Fixture, f *LeagueTableFilter) (bool, error)
+}
+
+type leagueTableFilterClassifier struct {
+	teamClient   statisticodata.TeamClient
+	resultClient statisticodata.ResultClient
+	clock        clockwork.Clock
+	seasons      map[uint64]*seasonResults
+	lock         sync.Mutex
+}
+
+// seasonResults contains every result of each team in a season at the time the results were fetched.
+type seasonResults struct {
+	Teams     []*teamResults
+	FetchedAt time.Time
+}
+
+type teamResults struct {
+	TeamID  uint64
+	Results []*statistico.Result
+}
+
+// standing is a single row of a league table calculated from the results of a season.
+type standing struct {
+	TeamID       uint64
+	Played       int
+	Points       int
+	GoalsFor     int
+	GoalsAgainst int
+}
+
+// MatchesFilter calculates the league table for the Fixture season using results played before the Fixture date
+// and determines if the table matches the provided LeagueTableFilter. A filter is not matched if either team has
+// yet to play a game in the season.
+func (l *leagueTableFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *LeagueTableFilter) (bool, error) {
+	teamID, err := parseTeamID(fix, f.Team)
+
+	if err != nil {
+		return false, err
+	}
+
+	opponentID, err := parseOpponentID(fix, f.Team)
+
+	if err != nil {
+		return false, err
+	}
+
+	table, err := l.leagueTable(ctx, fix.SeasonID, fix.Date)
+
+	if err != nil {
+		return false, err
+	}
+
+	team, position := findStanding(table, teamID)
+	opponent, opponentPosition := findStanding(table, opponentID)
+
+	if team == nil || opponent == nil || team.Played == 0 || opponent.Played == 0 {
+		return false, nil
+	}
+
+	var value int
+
+	switch f.Measure {
+	case Position:
+		value = position
+	case PositionFromBottom:
+		value = len(table) - position + 1
+	case PositionGap:
+		value = opponentPosition - position
+	default:
+		return false, fmt.Errorf("measure %s is not supported", f.Measure)
+	}
+
+	switch f.Metric {
+	case Gte:
+		return value >= f.Value, nil
+	case Lte:
+		return value <= f.Value, nil
+	default:
+		return false, fmt.Errorf("metric %s is not supported", f.Metric)
+	}
+}
+
+// leagueTable returns the standings for every team in a season using the results played before date, ordered by
+// points, goal difference, goals scored and finally team ID so the ordering is deterministic.
+func (l *leagueTableFilterClassifier) leagueTable(ctx context.Context, seasonID uint64, date time.Time) ([]*standing, error) {
+	season, err := l.seasonResults(ctx, seasonID, date)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var table []*standing
+
+	for _, tr := range season.Teams {
+		var played []*statistico.Result
+
+		for _, res := range tr.Results {
+			if res.GetDateTime().GetUtc() < date.Unix() {
+				played = append(played, res)
+			}
+		}
+
+		st, err := calculateStanding(tr.TeamID, played)
+
+		if err != nil {
+			return nil, err
+		}
+
+		table = append(table, st)
+	}
+
+	sort.SliceStable(table, func(i, j int) bool {
+		a, b := table[i], table[j]
+
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+
+		if a.GoalsFor-a.GoalsAgainst != b.GoalsFor-b.GoalsAgainst {
+			return a.GoalsFor-a.GoalsAgainst > b.GoalsFor-b.GoalsAgainst
+		}
+
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+
+		return a.TeamID < b.TeamID
+	})
+
+	return table, nil
+}
+
+// seasonResults returns the results of every team in a season. Results are cached so the league table for each
+// fixture in a season is calculated without further requests. Cached results include every result played before
+// fixtures dated before they were fetched, so they are only refreshed for later fixtures, such as upcoming fixtures
+// when trading live, once they are older than leagueTableCacheTTL.
+func (l *leagueTableFilterClassifier) seasonResults(ctx context.Context, seasonID uint64, date time.Time) (*seasonResults, error) {
+	l.lock.Lock()
+	cached, ok := l.seasons[seasonID]
+	l.lock.Unlock()
+
+	if ok && (date.Before(cached.FetchedAt) || l.clock.Since(cached.FetchedAt) < leagueTableCacheTTL) {
+		return cached, nil
+	}
+
+	season := seasonResults{FetchedAt: l.clock.Now()}
+
+	teams, err := l.teamClient.BySeasonID(ctx, seasonID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		req := statistico.TeamResultRequest{
+			TeamId:    team.GetId(),
+			SeasonIds: []uint64{seasonID},
+		}
+
+		results, err := l.resultClient.ByTeam(ctx, &req)
+
+		if err != nil {
+			return nil, err
+		}
+
+		season.Teams = append(season.Teams, &teamResults{TeamID: team.GetId(), Results: results})
+	}
+
+	l.lock.Lock()
+	l.seasons[seasonID] = &season
+	l.lock.Unlock()
+
+	return &season, nil
+}
+
+// calculateStanding calculates the standing of a team from its results, skipping results without a final score.
+func calculateStanding(teamID uint64, rs []*statistico.Result) (*standing, error) {
+	st := standing{TeamID: teamID}
+
+	for _, res := range rs {
+		if !hasFinalScore(res) {
+			continue
+		}
+
+		home, away, err := parseGoalScored(res)
+
+		if err != nil {
+			return nil, err
+		}
+
+		scored, conceded, err := parseTeamScore(res, teamID, ActionFor, home, away)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.Played++
+		st.Points += int(calculatePoints(scored, conceded))
+		st.GoalsFor += int(scored)
+		st.GoalsAgainst += int(conceded)
+	}
+
+	return &st, nil
+}
+
+// findStanding returns the standing and one based league position for a team.
+func findStanding(table []*standing, teamID uint64) (*standing, int) {
+	for i, st := range table {
+		if st.TeamID == teamID {
+			return st, i + 1
+		}
+	}
+
+	return nil, 0
+}
+
+func NewLeagueTableFilterClassifier(
+	t statisticodata.TeamClient,
+	r statisticodata.ResultClient,
+	c clockwork.Clock,
+) LeagueTableFilterClassifier {
+	return &leagueTableFilterClassifier{
+		teamClient:   t,
+		resultClient: r,
+		clock:        c,
+		seasons:      map[uint64]*seasonResults{},
+	}
+}
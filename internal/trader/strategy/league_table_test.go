@@ -0,0 +1,282 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/jonboulle/clockwork"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestLeagueTableFilterClassifier_MatchesFilter(t *testing.T) {
+	fixture := strategy.Fixture{
+		ID:         55,
+		HomeTeamID: 1,
+		AwayTeamID: 4,
+		Date:       time.Unix(1584014400, 0),
+		SeasonID:   8,
+	}
+
+	clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+
+	teams := []*statistico.Team{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}}
+
+	// Table before kick off: 2 (4 pts, +4), 1 (4 pts, +2), 3 (4 pts, +1), 4 (1 pt)
+	results := map[uint64][]*statistico.Result{
+		1: {newProtoResult(1, 4, 3, 1), newProtoResult(2, 1, 1, 1)},
+		2: {newProtoResult(2, 1, 1, 1), newProtoResult(2, 3, 1, 2), newProtoResult(4, 2, 0, 5)},
+		3: {newProtoResult(2, 3, 1, 2), newProtoResult(3, 4, 0, 0)},
+		4: {newProtoResult(1, 4, 3, 1), newProtoResult(3, 4, 0, 0), newProtoResult(4, 2, 0, 5)},
+	}
+
+	mockClients := func(ctx context.Context) (*m.TeamClient, *m.ResultClient) {
+		teamClient := new(m.TeamClient)
+		resultClient := new(m.ResultClient)
+
+		teamClient.On("BySeasonID", ctx, uint64(8)).Return(teams, nil)
+
+		for id, rs := range results {
+			id := id
+
+			req := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+				return r.TeamId == id && assert.Equal(t, []uint64{8}, r.SeasonIds) && r.GetDateBefore() == nil
+			})
+
+			resultClient.On("ByTeam", ctx, req).Return(rs, nil)
+		}
+
+		return teamClient, resultClient
+	}
+
+	t.Run("returns bool if league table matches league table filter", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			Filter   *strategy.LeagueTableFilter
+			Expected bool
+		}{
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 2},
+				Expected: true,
+			},
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 1},
+				Expected: false,
+			},
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "AWAY_TEAM", Measure: "POSITION_FROM_BOTTOM", Metric: "LTE", Value: 1},
+				Expected: true,
+			},
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION_GAP", Metric: "GTE", Value: 2},
+				Expected: true,
+			},
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "AWAY_TEAM", Measure: "POSITION_GAP", Metric: "GTE", Value: 0},
+				Expected: false,
+			},
+			{
+				Filter:   &strategy.LeagueTableFilter{Team: "AWAY_TEAM", Measure: "POSITION_GAP", Metric: "LTE", Value: -2},
+				Expected: true,
+			},
+		}
+
+		for index, a := range assertions {
+			ctx := context.Background()
+			teamClient, resultClient := mockClients(ctx)
+			classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+			success, err := classifier.MatchesFilter(ctx, &fixture, a.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), index)
+			}
+
+			assert.Equal(t, a.Expected, success, "index %d", index)
+		}
+	})
+
+	t.Run("returns false if a team has not played a game in the season", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		teamClient := new(m.TeamClient)
+		resultClient := new(m.ResultClient)
+
+		teamClient.On("BySeasonID", ctx, uint64(8)).Return(teams, nil)
+		resultClient.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{}, nil)
+
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 4}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("fetches season results once and uses results played before each fixture", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		teamClient := new(m.TeamClient)
+		resultClient := new(m.ResultClient)
+
+		played := func(r *statistico.Result, date time.Time) *statistico.Result {
+			r.DateTime = &statistico.Date{Utc: date.Unix()}
+			return r
+		}
+
+		earlier := fixture.Date.Add(-14 * 24 * time.Hour)
+		later := fixture.Date.Add(-7 * 24 * time.Hour)
+
+		// Team 1 beat team 4 before both fixtures and lost to team 4 between them
+		teamClient.On("BySeasonID", ctx, uint64(8)).Return([]*statistico.Team{{Id: 1}, {Id: 4}}, nil)
+		resultClient.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).Return(
+			[]*statistico.Result{
+				played(newProtoResult(1, 4, 2, 0), earlier.Add(-time.Hour)),
+				played(newProtoResult(4, 1, 3, 0), later),
+			},
+			nil,
+		)
+
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 1}
+
+		first := fixture
+		first.Date = earlier
+
+		success, err := classifier.MatchesFilter(ctx, &first, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, success)
+
+		success, err = classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+		teamClient.AssertNumberOfCalls(t, "BySeasonID", 1)
+		resultClient.AssertNumberOfCalls(t, "ByTeam", 2)
+	})
+
+	t.Run("skips results without a score", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		teamClient := new(m.TeamClient)
+		resultClient := new(m.ResultClient)
+
+		unscored := newProtoResult(4, 1, 0, 0)
+		unscored.Stats.AwayScore = nil
+
+		teamClient.On("BySeasonID", ctx, uint64(8)).Return([]*statistico.Team{{Id: 1}, {Id: 4}}, nil)
+		resultClient.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{newProtoResult(1, 4, 2, 0), unscored}, nil)
+
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 1}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, success)
+	})
+
+	t.Run("returns error if measure is not supported", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		teamClient, resultClient := mockClients(ctx)
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POINTS", Metric: "LTE", Value: 4}
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "measure POINTS is not supported", err.Error())
+	})
+
+	t.Run("returns error if returned by team client", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		teamClient := new(m.TeamClient)
+		resultClient := new(m.ResultClient)
+
+		teamClient.On("BySeasonID", ctx, uint64(8)).Return([]*statistico.Team{}, errors.New("oh no"))
+
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 4}
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+		resultClient.AssertNotCalled(t, "ByTeam")
+	})
+
+	t.Run("refreshes season results for fixtures dated after the results were fetched once they expire", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		teamClient, resultClient := mockClients(ctx)
+		clock := clockwork.NewFakeClockAt(time.Unix(1616936636, 0))
+		classifier := strategy.NewLeagueTableFilterClassifier(teamClient, resultClient, clock)
+
+		upcoming := fixture
+		upcoming.Date = clock.Now().Add(24 * time.Hour)
+
+		f := strategy.LeagueTableFilter{Team: "HOME_TEAM", Measure: "POSITION", Metric: "LTE", Value: 2}
+
+		for i := 0; i < 2; i++ {
+			if _, err := classifier.MatchesFilter(ctx, &upcoming, &f); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			clock.Advance(59 * time.Minute)
+		}
+
+		teamClient.AssertNumberOfCalls(t, "BySeasonID", 1)
+		resultClient.AssertNumberOfCalls(t, "ByTeam", 4)
+
+		if _, err := classifier.MatchesFilter(ctx, &upcoming, &f); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		teamClient.AssertNumberOfCalls(t, "BySeasonID", 2)
+		resultClient.AssertNumberOfCalls(t, "ByTeam", 8)
+	})
+}
@@ -0,0 +1,88 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"sort"
+	"time"
+)
+
+// teamResultFetcher fetches the results used to evaluate ResultFilter and StatFilter structs, resolving the seasons
+// to search based on the filter lookback.
+type teamResultFetcher struct {
+	resultClient statisticodata.ResultClient
+	seasonClient statisticodata.SeasonClient
+}
+
+// fetch returns up to the number of games provided for a team played before the Fixture date. An empty lookback is
+// treated as CURRENT_SEASON, ROLLING returns the most recent results regardless of season and PREVIOUS_SEASON
+// returns results from the competition season prior to the Fixture season.
+func (t *teamResultFetcher) fetch(ctx context.Context, fix *Fixture, teamID uint64, games uint8, venue, lookback string) ([]*statistico.Result, error) {
+	seasonIDs, err := t.seasonIDs(ctx, fix, lookback)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := statistico.TeamResultRequest{
+		TeamId:     teamID,
+		Limit:      &wrappers.UInt64Value{Value: uint64(games)},
+		DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
+		SeasonIds:  seasonIDs,
+		Venue:      &wrappers.StringValue{Value: venue},
+	}
+
+	return t.resultClient.ByTeam(ctx, &req)
+}
+
+func (t *teamResultFetcher) seasonIDs(ctx context.Context, fix *Fixture, lookback string) ([]uint64, error) {
+	switch lookback {
+	case "", LookbackCurrentSeason:
+		return []uint64{fix.SeasonID}, nil
+	case LookbackRolling:
+		return nil, nil
+	case LookbackPreviousSeason:
+		id, err := t.previousSeasonID(ctx, fix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []uint64{id}, nil
+	default:
+		return nil, fmt.Errorf("lookback %s is not supported", lookback)
+	}
+}
+
+func (t *teamResultFetcher) previousSeasonID(ctx context.Context, fix *Fixture) (uint64, error) {
+	seasons, err := t.seasonClient.ByCompetitionID(ctx, fix.CompetitionID, "name_asc")
+
+	if err != nil {
+		return 0, err
+	}
+
+	sort.SliceStable(seasons, func(i, j int) bool {
+		return seasons[i].GetName() < seasons[j].GetName()
+	})
+
+	for i, s := range seasons {
+		if s.GetId() == fix.SeasonID && i > 0 {
+			return seasons[i-1].GetId(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unable to find season prior to season %d for competition %d", fix.SeasonID, fix.CompetitionID)
+}
+
+// meetsMinimumGames determines if enough results have been returned to evaluate a filter. A minimum of zero
+// requires the full number of games so filters do not match on small samples unless they opt in to doing so.
+func meetsMinimumGames(rs []*statistico.Result, games, min uint8) bool {
+	if min == 0 {
+		min = games
+	}
+
+	return len(rs) >= int(min)
+}
@@ -0,0 +1,156 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+const (
+	ResampleMonteCarlo = "RESAMPLE"
+	ShuffleMonteCarlo  = "SHUFFLE"
+
+	maxMonteCarloIterations = 100000
+
+	// DefaultRuinPercentage is used when MonteCarloOptions does not provide a RuinPercentage.
+	DefaultRuinPercentage = 50
+)
+
+// MonteCarloOptions configures a Monte Carlo simulation. SHUFFLE reorders the backtest trades for each
+// iteration and RESAMPLE draws the same number of trades with replacement. A path is ruined if it can no longer
+// cover its next stake or its balance falls to or below RuinPercentage percent of the starting bankroll, which
+// defaults to DefaultRuinPercentage. Seed makes simulations reproducible.
+type MonteCarloOptions struct {
+	Iterations     int     `json:"iterations"`
+	Method         string  `json:"method"`
+	RuinPercentage float64 `json:"ruinPercentage"`
+	Seed           int64   `json:"seed"`
+}
+
+// Distribution summarises the values produced by the iterations of a Monte Carlo simulation.
+type Distribution struct {
+	Mean   float64 `json:"mean"`
+	Min    float64 `json:"min"`
+	P5     float64 `json:"p5"`
+	P25    float64 `json:"p25"`
+	Median float64 `json:"median"`
+	P75    float64 `json:"p75"`
+	P95    float64 `json:"p95"`
+	Max    float64 `json:"max"`
+}
+
+// MonteCarloSimulation contains the distributions of final bankroll and maximum drawdown, the largest fall of a
+// path as a percentage of the peak it fell from, across every path along with the proportion of paths that were ruined.
+type MonteCarloSimulation struct {
+	Options           MonteCarloOptions `json:"options"`
+	StakingPlan       StakingPlan       `json:"stakingPlan"`
+	StartingBankroll  float64           `json:"startingBankroll"`
+	FinalBankroll     Distribution      `json:"finalBankroll"`
+	MaxDrawdown       Distribution      `json:"maxDrawdown"`
+	ProbabilityOfRuin float64           `json:"probabilityOfRuin"`
+}
+
+// SimulateMonteCarlo replays the trades of a completed backtest in randomised sequences against the bankroll
+// provided using the StakingPlan, deducting the commission each trade's exchange charges the user provided.
+func SimulateMonteCarlo(trades []*Trade, plan StakingPlan, bankroll float32, c Commission, userID string, o MonteCarloOptions) (*MonteCarloSimulation, error) {
+	if err := validateBankroll(plan, bankroll); err != nil {
+		return nil, err
+	}
+
+	if o.Iterations <= 0 || o.Iterations > maxMonteCarloIterations {
+		return nil, fmt.Errorf("iterations must be between 1 and %d", maxMonteCarloIterations)
+	}
+
+	if o.Method != ShuffleMonteCarlo && o.Method != ResampleMonteCarlo {
+		return nil, fmt.Errorf("monte carlo method %s is not supported", o.Method)
+	}
+
+	if o.RuinPercentage == 0 {
+		o.RuinPercentage = DefaultRuinPercentage
+	}
+
+	if o.RuinPercentage < 0 || o.RuinPercentage >= 100 {
+		return nil, errors.New("ruin percentage must be between 0 and 100")
+	}
+
+	r := rand.New(rand.NewSource(o.Seed))
+	sequence := sortTradesByEventDate(trades)
+	finals := make([]float64, o.Iterations)
+	drawdowns := make([]float64, o.Iterations)
+	threshold := float64(bankroll) * o.RuinPercentage / 100
+	ruined := 0
+
+	for i := 0; i < o.Iterations; i++ {
+		path := monteCarloPath(r, sequence, o.Method)
+
+		sim, err := replayBankroll(path, plan, bankroll, c, userID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		finals[i] = sim.FinalBankroll
+		drawdowns[i] = sim.MaxDrawdownPercentage
+
+		if sim.Ruined || sim.LowestBankroll <= threshold {
+			ruined++
+		}
+	}
+
+	return &MonteCarloSimulation{
+		Options:           o,
+		StakingPlan:       plan,
+		StartingBankroll:  float64(bankroll),
+		FinalBankroll:     newDistribution(finals),
+		MaxDrawdown:       newDistribution(drawdowns),
+		ProbabilityOfRuin: float64(ruined) / float64(o.Iterations),
+	}, nil
+}
+
+func monteCarloPath(r *rand.Rand, trades []*Trade, method string) []*Trade {
+	path := make([]*Trade, len(trades))
+
+	if method == ResampleMonteCarlo {
+		for i := range path {
+			path[i] = trades[r.Intn(len(trades))]
+		}
+
+		return path
+	}
+
+	copy(path, trades)
+
+	r.Shuffle(len(path), func(i, j int) {
+		path[i], path[j] = path[j], path[i]
+	})
+
+	return path
+}
+
+func newDistribution(values []float64) Distribution {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	var total float64
+
+	for _, v := range sorted {
+		total += v
+	}
+
+	percentile := func(p float64) float64 {
+		return sorted[int(p/100*float64(len(sorted)-1)+0.5)]
+	}
+
+	return Distribution{
+		Mean:   total / float64(len(sorted)),
+		Min:    sorted[0],
+		P5:     percentile(5),
+		P25:    percentile(25),
+		Median: percentile(50),
+		P75:    percentile(75),
+		P95:    percentile(95),
+		Max:    sorted[len(sorted)-1],
+	}
+}
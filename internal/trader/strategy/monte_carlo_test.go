@@ -0,0 +1,154 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSimulateMonteCarlo(t *testing.T) {
+	trades := []*strategy.Trade{
+		newSummaryTrade(1, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+		newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+		newSummaryTrade(4, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		newSummaryTrade(5, 8, 17420, "BACK", 3.00, "SUCCESS"),
+	}
+
+	t.Run("shuffled paths with fixed stakes always finish with the same bankroll", func(t *testing.T) {
+		t.Helper()
+
+		opts := strategy.MonteCarloOptions{Iterations: 500, Method: "SHUFFLE", Seed: 1}
+
+		sim, err := strategy.SimulateMonteCarlo(trades, strategy.StakingPlan{Name: "FIXED", Number: 10}, 100, strategy.Commission{}, "", opts)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		opts.RuinPercentage = strategy.DefaultRuinPercentage
+
+		a.Equal(opts, sim.Options)
+		a.InDelta(120.0, sim.FinalBankroll.Min, 0.0001)
+		a.InDelta(120.0, sim.FinalBankroll.Max, 0.0001)
+		a.InDelta(120.0, sim.FinalBankroll.Mean, 0.0001)
+		a.LessOrEqual(sim.MaxDrawdown.Min, sim.MaxDrawdown.Median)
+		a.LessOrEqual(sim.MaxDrawdown.Median, sim.MaxDrawdown.Max)
+		a.InDelta(20.0, sim.MaxDrawdown.Max, 0.0001)
+		a.Equal(0.0, sim.ProbabilityOfRuin)
+	})
+
+	t.Run("resampled paths produce a distribution of final bankrolls and ruin", func(t *testing.T) {
+		t.Helper()
+
+		opts := strategy.MonteCarloOptions{Iterations: 2000, Method: "RESAMPLE", RuinPercentage: 70, Seed: 1}
+
+		sim, err := strategy.SimulateMonteCarlo(trades, strategy.StakingPlan{Name: "FIXED", Number: 10}, 100, strategy.Commission{}, "", opts)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.GreaterOrEqual(sim.FinalBankroll.Min, 50.0)
+		a.LessOrEqual(sim.FinalBankroll.Max, 200.0)
+		a.Less(sim.FinalBankroll.P5, sim.FinalBankroll.P95)
+		a.Greater(sim.ProbabilityOfRuin, 0.0)
+		a.Less(sim.ProbabilityOfRuin, 0.5)
+
+		again, err := strategy.SimulateMonteCarlo(trades, strategy.StakingPlan{Name: "FIXED", Number: 10}, 100, strategy.Commission{}, "", opts)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a.Equal(sim, again)
+	})
+
+	t.Run("counts paths that can no longer cover the next stake as ruined", func(t *testing.T) {
+		t.Helper()
+
+		losing := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(4, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(5, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		}
+
+		opts := strategy.MonteCarloOptions{Iterations: 100, Method: "SHUFFLE", RuinPercentage: 1, Seed: 1}
+
+		sim, err := strategy.SimulateMonteCarlo(losing, strategy.StakingPlan{Name: "PERCENTAGE", Number: 50}, 10, strategy.Commission{}, "", opts)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1.0, sim.ProbabilityOfRuin)
+		a.Greater(sim.FinalBankroll.Min, 0.1)
+	})
+
+	t.Run("measures drawdown as the largest percentage fall of each path", func(t *testing.T) {
+		t.Helper()
+
+		// Every order of the trades falls at least 19% from a peak. FAIL, FAIL, SUCCESS, FAIL falls 19 from 100 and
+		// 89.1 from 891, so the largest fall in money is only 10% of its peak.
+		dips := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(4, 8, 17420, "BACK", 101.00, "SUCCESS"),
+		}
+
+		opts := strategy.MonteCarloOptions{Iterations: 200, Method: "SHUFFLE", Seed: 1}
+
+		sim, err := strategy.SimulateMonteCarlo(dips, strategy.StakingPlan{Name: "PERCENTAGE", Number: 10}, 100, strategy.Commission{}, "", opts)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(19.0, sim.MaxDrawdown.Min, 0.0001)
+		a.InDelta(19.0, sim.MaxDrawdown.P5, 0.0001)
+		a.InDelta(27.1, sim.MaxDrawdown.Max, 0.0001)
+	})
+
+	t.Run("returns an error if the options are not valid", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Options strategy.MonteCarloOptions
+			Error   string
+		}{
+			{
+				Options: strategy.MonteCarloOptions{Iterations: 0, Method: "SHUFFLE"},
+				Error:   "iterations must be between 1 and 100000",
+			},
+			{
+				Options: strategy.MonteCarloOptions{Iterations: 10, Method: "BOOTSTRAP"},
+				Error:   "monte carlo method BOOTSTRAP is not supported",
+			},
+			{
+				Options: strategy.MonteCarloOptions{Iterations: 10, Method: "SHUFFLE", RuinPercentage: 100},
+				Error:   "ruin percentage must be between 0 and 100",
+			},
+		}
+
+		for _, c := range tc {
+			_, err := strategy.SimulateMonteCarlo(trades, strategy.StakingPlan{Name: "FIXED", Number: 10}, 100, strategy.Commission{}, "", c.Options)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
@@ -0,0 +1,317 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	MinOddsParameter           = "MIN_ODDS"
+	MaxOddsParameter           = "MAX_ODDS"
+	ResultFilterGamesParameter = "RESULT_FILTER_GAMES"
+	StatFilterGamesParameter   = "STAT_FILTER_GAMES"
+	StatFilterValueParameter   = "STAT_FILTER_VALUE"
+
+	RankByNetProfit  = "NET_PROFIT"
+	RankByROI        = "ROI"
+	RankByStrikeRate = "STRIKE_RATE"
+	RankByYield      = "YIELD"
+
+	maxOptimiserCombinations = 1000
+)
+
+type Optimiser interface {
+	// Optimise builds the OptimiserQuery Query for every combination of Parameters and returns the Performance of
+	// each combination ranked from best to worst. Combinations settling fewer trades than the minimum sample are
+	// flagged as InsufficientSample and ranked after every combination that meets it. Combinations that only differ
+	// by MIN_ODDS or MAX_ODDS are built once and the trades filtered by odds.
+	Optimise(ctx context.Context, q *OptimiserQuery) ([]*OptimisationResult, error)
+}
+
+// SweepParameter is a BuilderQuery value evaluated by an Optimiser. Values is a grid of values to evaluate and if
+// it is empty every value from From to To in increments of Step is evaluated. Filter is the index of the result
+// or stat filter the RESULT_FILTER_GAMES, STAT_FILTER_GAMES and STAT_FILTER_VALUE parameters are applied to.
+type SweepParameter struct {
+	Name   string    `json:"name"`
+	Filter int       `json:"filter"`
+	Values []float32 `json:"values"`
+	From   float32   `json:"from"`
+	To     float32   `json:"to"`
+	Step   float32   `json:"step"`
+}
+
+// OptimiserQuery contains the BuilderQuery to optimise and the parameters to evaluate. Results are ranked using
+// RankBy, which is one of NET_PROFIT, ROI (the default), STRIKE_RATE or YIELD, with profit calculated net of the
+// commission charged to UserID.
+type OptimiserQuery struct {
+	Query      *BuilderQuery
+	Parameters []*SweepParameter
+	RankBy     string
+	Commission Commission
+	UserID     string
+}
+
+type ParameterValue struct {
+	Name   string  `json:"name"`
+	Filter int     `json:"filter"`
+	Value  float32 `json:"value"`
+}
+
+type OptimisationResult struct {
+	Parameters         []ParameterValue `json:"parameters"`
+	Performance        Performance      `json:"performance"`
+	InsufficientSample bool             `json:"insufficientSample"`
+}
+
+type optimiser struct {
+	builder   Builder
+	minSample int
+}
+
+func (o *optimiser) Optimise(ctx context.Context, q *OptimiserQuery) ([]*OptimisationResult, error) {
+	metric, err := rankMetric(q.RankBy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	combinations, err := parameterCombinations(q.Parameters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*OptimisationResult{}
+	built := map[string][]*Trade{}
+
+	for _, params := range combinations {
+		query, err := applyParameters(q.Query, params)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key := buildKey(params)
+		trades, ok := built[key]
+
+		if !ok {
+			for t := range o.builder.Build(ctx, withoutSweptOdds(query, params)) {
+				trades = append(trades, t)
+			}
+
+			built[key] = trades
+		}
+
+		trades = filterTradesByOdds(trades, query.MinOdds, query.MaxOdds)
+
+		p, err := calculatePerformance(sortTradesByEventDate(trades), q.Commission, q.UserID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &OptimisationResult{
+			Parameters:         params,
+			Performance:        *p,
+			InsufficientSample: p.Trades < o.minSample,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].InsufficientSample != results[j].InsufficientSample {
+			return !results[i].InsufficientSample
+		}
+
+		return metric(results[i].Performance) > metric(results[j].Performance)
+	})
+
+	return results, nil
+}
+
+func (p SweepParameter) values() ([]float32, error) {
+	if len(p.Values) > 0 {
+		return p.Values, nil
+	}
+
+	if p.Step <= 0 || p.To < p.From {
+		return nil, fmt.Errorf("parameter %s requires values or a range with a positive step", p.Name)
+	}
+
+	steps := int(math.Round(float64((p.To - p.From) / p.Step)))
+	values := []float32{}
+
+	for i := 0; i <= steps; i++ {
+		v := float64(p.From) + float64(i)*float64(p.Step)
+		values = append(values, float32(math.Round(v*10000)/10000))
+	}
+
+	return values, nil
+}
+
+func parameterCombinations(params []*SweepParameter) ([][]ParameterValue, error) {
+	combinations := [][]ParameterValue{{}}
+
+	for _, p := range params {
+		values, err := p.values()
+
+		if err != nil {
+			return nil, err
+		}
+
+		next := [][]ParameterValue{}
+
+		for _, c := range combinations {
+			for _, v := range values {
+				cp := make([]ParameterValue, len(c), len(c)+1)
+				copy(cp, c)
+				next = append(next, append(cp, ParameterValue{Name: p.Name, Filter: p.Filter, Value: v}))
+			}
+		}
+
+		if len(next) > maxOptimiserCombinations {
+			return nil, fmt.Errorf("optimisation exceeds the maximum of %d combinations", maxOptimiserCombinations)
+		}
+
+		combinations = next
+	}
+
+	return combinations, nil
+}
+
+// buildKey identifies the trades built for a combination of parameters. MIN_ODDS and MAX_ODDS values are excluded
+// so combinations that only differ by odds share the trades built for the widest odds range.
+func buildKey(params []ParameterValue) string {
+	key := ""
+
+	for _, p := range params {
+		if p.Name != MinOddsParameter && p.Name != MaxOddsParameter {
+			key += fmt.Sprintf("%s:%d:%v;", p.Name, p.Filter, p.Value)
+		}
+	}
+
+	return key
+}
+
+// withoutSweptOdds returns a copy of the BuilderQuery without the odds bounds set by MIN_ODDS and MAX_ODDS
+// parameters so trades can be built once and filtered by each odds value evaluated.
+func withoutSweptOdds(q *BuilderQuery, params []ParameterValue) *BuilderQuery {
+	query := *q
+
+	for _, p := range params {
+		switch p.Name {
+		case MinOddsParameter:
+			query.MinOdds = nil
+		case MaxOddsParameter:
+			query.MaxOdds = nil
+		}
+	}
+
+	return &query
+}
+
+func filterTradesByOdds(trades []*Trade, min, max *float32) []*Trade {
+	filtered := []*Trade{}
+
+	for _, t := range trades {
+		if (min != nil && t.Price < *min) || (max != nil && t.Price > *max) {
+			continue
+		}
+
+		filtered = append(filtered, t)
+	}
+
+	return filtered
+}
+
+// applyParameters returns a copy of the BuilderQuery with the parameter values applied. Filters are copied
+// before being changed so the original query is not modified.
+func applyParameters(q *BuilderQuery, params []ParameterValue) (*BuilderQuery, error) {
+	query := *q
+
+	query.ResultFilters = make([]*ResultFilter, len(q.ResultFilters))
+
+	for i, f := range q.ResultFilters {
+		cp := *f
+		query.ResultFilters[i] = &cp
+	}
+
+	query.StatFilters = make([]*StatFilter, len(q.StatFilters))
+
+	for i, f := range q.StatFilters {
+		cp := *f
+		query.StatFilters[i] = &cp
+	}
+
+	for _, p := range params {
+		value := p.Value
+
+		switch p.Name {
+		case MinOddsParameter:
+			query.MinOdds = &value
+		case MaxOddsParameter:
+			query.MaxOdds = &value
+		case ResultFilterGamesParameter:
+			if p.Filter < 0 || p.Filter >= len(query.ResultFilters) {
+				return nil, fmt.Errorf("result filter %d does not exist", p.Filter)
+			}
+
+			games, err := gamesValue(p)
+
+			if err != nil {
+				return nil, err
+			}
+
+			query.ResultFilters[p.Filter].Games = games
+		case StatFilterGamesParameter, StatFilterValueParameter:
+			if p.Filter < 0 || p.Filter >= len(query.StatFilters) {
+				return nil, fmt.Errorf("stat filter %d does not exist", p.Filter)
+			}
+
+			if p.Name == StatFilterValueParameter {
+				query.StatFilters[p.Filter].Value = value
+				continue
+			}
+
+			games, err := gamesValue(p)
+
+			if err != nil {
+				return nil, err
+			}
+
+			query.StatFilters[p.Filter].Games = games
+		default:
+			return nil, fmt.Errorf("parameter %s is not supported", p.Name)
+		}
+	}
+
+	return &query, nil
+}
+
+func gamesValue(p ParameterValue) (uint8, error) {
+	if p.Value < 0 || p.Value > math.MaxUint8 || p.Value != float32(math.Trunc(float64(p.Value))) {
+		return 0, fmt.Errorf("parameter %s value %v must be a whole number between 0 and %d", p.Name, p.Value, math.MaxUint8)
+	}
+
+	return uint8(p.Value), nil
+}
+
+func rankMetric(rankBy string) (func(p Performance) float64, error) {
+	switch rankBy {
+	case RankByNetProfit:
+		return func(p Performance) float64 { return p.NetProfit }, nil
+	case RankByROI, "":
+		return func(p Performance) float64 { return p.ROI }, nil
+	case RankByStrikeRate:
+		return func(p Performance) float64 { return p.StrikeRate }, nil
+	case RankByYield:
+		return func(p Performance) float64 { return p.Yield }, nil
+	default:
+		return nil, fmt.Errorf("rank by %s is not supported", rankBy)
+	}
+}
+
+func NewOptimiser(b Builder, minSample int) Optimiser {
+	return &optimiser{builder: b, minSample: minSample}
+}
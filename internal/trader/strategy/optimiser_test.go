@@ -0,0 +1,264 @@
+package strategy_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestOptimiser_Optimise(t *testing.T) {
+	query := strategy.BuilderQuery{
+		Market: "MATCH_ODDS",
+		Runner: "Home",
+		Side:   "BACK",
+		StatFilters: []*strategy.StatFilter{
+			{
+				Stat:    "GOALS",
+				Team:    "HOME_TEAM",
+				Action:  "FOR",
+				Games:   3,
+				Measure: "AVERAGE",
+				Metric:  "GTE",
+				Value:   1.5,
+			},
+		},
+	}
+
+	t.Run("builds every combination of parameters and ranks the results", func(t *testing.T) {
+		t.Helper()
+
+		builder := new(MockBuilder)
+		optimiser := strategy.NewOptimiser(builder, 0)
+
+		ctx := context.Background()
+
+		for _, games := range []uint8{3, 5} {
+			g := games
+
+			trades := []*strategy.Trade{
+				newSummaryTrade(1, 8, 17420, "BACK", 1.60, "FAIL"),
+				newSummaryTrade(2, 8, 17420, "BACK", 1.70, "FAIL"),
+				newSummaryTrade(3, 8, 17420, "BACK", 3.00, "SUCCESS"),
+			}
+
+			q := mock.MatchedBy(func(b *strategy.BuilderQuery) bool {
+				return b.MinOdds == nil && b.StatFilters[0].Games == g
+			})
+
+			builder.On("Build", ctx, q).Once().Return(tradeChannel(trades))
+		}
+
+		q := strategy.OptimiserQuery{
+			Query: &query,
+			Parameters: []*strategy.SweepParameter{
+				{Name: "MIN_ODDS", From: 1.5, To: 2.0, Step: 0.5},
+				{Name: "STAT_FILTER_GAMES", Filter: 0, Values: []float32{3, 5}},
+			},
+			RankBy: "NET_PROFIT",
+		}
+
+		results, err := optimiser.Optimise(ctx, &q)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(4, len(results))
+		a.Equal(
+			[]strategy.ParameterValue{
+				{Name: "MIN_ODDS", Value: 2.0},
+				{Name: "STAT_FILTER_GAMES", Filter: 0, Value: 3},
+			},
+			results[0].Parameters,
+		)
+		a.InDelta(2.0, results[0].Performance.NetProfit, 0.0001)
+		a.Equal(1, results[0].Performance.Trades)
+		a.InDelta(2.0, results[1].Performance.NetProfit, 0.0001)
+		a.InDelta(0.0, results[3].Performance.NetProfit, 0.0001)
+		a.Equal(uint8(3), query.StatFilters[0].Games)
+		a.Nil(query.MinOdds)
+		builder.AssertExpectations(t)
+		builder.AssertNumberOfCalls(t, "Build", 2)
+	})
+
+	t.Run("ranks combinations below the minimum sample after those that meet it", func(t *testing.T) {
+		t.Helper()
+
+		builder := new(MockBuilder)
+		optimiser := strategy.NewOptimiser(builder, 2)
+
+		ctx := context.Background()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 1.60, "FAIL"),
+			newSummaryTrade(2, 8, 17420, "BACK", 1.70, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 3.00, "SUCCESS"),
+		}
+
+		builder.On("Build", ctx, mock.AnythingOfType("*strategy.BuilderQuery")).Once().Return(tradeChannel(trades))
+
+		q := strategy.OptimiserQuery{
+			Query: &query,
+			Parameters: []*strategy.SweepParameter{
+				{Name: "MIN_ODDS", Values: []float32{2.0, 1.5}},
+			},
+			RankBy: "NET_PROFIT",
+		}
+
+		results, err := optimiser.Optimise(ctx, &q)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(results))
+		a.Equal([]strategy.ParameterValue{{Name: "MIN_ODDS", Value: 1.5}}, results[0].Parameters)
+		a.Equal(3, results[0].Performance.Trades)
+		a.InDelta(0.0, results[0].Performance.NetProfit, 0.0001)
+		a.False(results[0].InsufficientSample)
+		a.Equal([]strategy.ParameterValue{{Name: "MIN_ODDS", Value: 2.0}}, results[1].Parameters)
+		a.Equal(1, results[1].Performance.Trades)
+		a.InDelta(2.0, results[1].Performance.NetProfit, 0.0001)
+		a.True(results[1].InsufficientSample)
+		builder.AssertExpectations(t)
+	})
+
+	t.Run("returns an error if a parameter refers to a filter that does not exist", func(t *testing.T) {
+		t.Helper()
+
+		optimiser := strategy.NewOptimiser(new(MockBuilder), 0)
+
+		q := strategy.OptimiserQuery{
+			Query: &query,
+			Parameters: []*strategy.SweepParameter{
+				{Name: "RESULT_FILTER_GAMES", Filter: 0, Values: []float32{3, 5}},
+			},
+		}
+
+		_, err := optimiser.Optimise(context.Background(), &q)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "result filter 0 does not exist", err.Error())
+	})
+
+	t.Run("returns an error if a games parameter is not a whole number within range", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			Value float32
+			Error string
+		}{
+			{Value: 2.5, Error: "parameter STAT_FILTER_GAMES value 2.5 must be a whole number between 0 and 255"},
+			{Value: 256, Error: "parameter STAT_FILTER_GAMES value 256 must be a whole number between 0 and 255"},
+			{Value: -1, Error: "parameter STAT_FILTER_GAMES value -1 must be a whole number between 0 and 255"},
+		}
+
+		for i, a := range assertions {
+			optimiser := strategy.NewOptimiser(new(MockBuilder), 0)
+
+			q := strategy.OptimiserQuery{
+				Query: &query,
+				Parameters: []*strategy.SweepParameter{
+					{Name: "STAT_FILTER_GAMES", Filter: 0, Values: []float32{a.Value}},
+				},
+			}
+
+			_, err := optimiser.Optimise(context.Background(), &q)
+
+			if err == nil {
+				t.Fatalf("Expected error, got nil at index %d", i)
+			}
+
+			assert.Equal(t, a.Error, err.Error(), "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if a parameter range is not valid", func(t *testing.T) {
+		t.Helper()
+
+		optimiser := strategy.NewOptimiser(new(MockBuilder), 0)
+
+		q := strategy.OptimiserQuery{
+			Query: &query,
+			Parameters: []*strategy.SweepParameter{
+				{Name: "MAX_ODDS", From: 3.0, To: 2.0, Step: 0.5},
+			},
+		}
+
+		_, err := optimiser.Optimise(context.Background(), &q)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "parameter MAX_ODDS requires values or a range with a positive step", err.Error())
+	})
+
+	t.Run("returns an error if there are too many combinations", func(t *testing.T) {
+		t.Helper()
+
+		optimiser := strategy.NewOptimiser(new(MockBuilder), 0)
+
+		q := strategy.OptimiserQuery{
+			Query: &query,
+			Parameters: []*strategy.SweepParameter{
+				{Name: "MIN_ODDS", From: 1.0, To: 10.0, Step: 0.1},
+				{Name: "MAX_ODDS", From: 1.0, To: 10.0, Step: 0.1},
+			},
+		}
+
+		_, err := optimiser.Optimise(context.Background(), &q)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "optimisation exceeds the maximum of 1000 combinations", err.Error())
+	})
+
+	t.Run("returns an error if rank by is not supported", func(t *testing.T) {
+		t.Helper()
+
+		optimiser := strategy.NewOptimiser(new(MockBuilder), 0)
+
+		q := strategy.OptimiserQuery{Query: &query, RankBy: "LUCK"}
+
+		_, err := optimiser.Optimise(context.Background(), &q)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "rank by LUCK is not supported", err.Error())
+	})
+}
+
+type MockBuilder struct {
+	mock.Mock
+}
+
+func (m *MockBuilder) Build(ctx context.Context, q *strategy.BuilderQuery) <-chan *strategy.Trade {
+	args := m.Called(ctx, q)
+	return args.Get(0).(<-chan *strategy.Trade)
+}
+
+func tradeChannel(trades []*strategy.Trade) <-chan *strategy.Trade {
+	ch := make(chan *strategy.Trade, len(trades))
+
+	for _, t := range trades {
+		ch <- t
+	}
+
+	close(ch)
+
+	return ch
+}
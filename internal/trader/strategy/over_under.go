@@ -0,0 +1,95 @@
+package strategy
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-proto/go"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// overUnderMarket matches totals markets such as OVER_UNDER_25, OVER_UNDER_105_CORNERS and OVER_UNDER_45_CARDS where
+// the digits are the line multiplied by ten.
+var overUnderMarket = regexp.MustCompile(`^OVER_UNDER_(\d+)(_CORNERS|_CARDS)?$`)
+
+// parseOverUnderMarket returns the stat and line of a totals market. Only half lines are supported so every
+// market settles as either Over or Under.
+func parseOverUnderMarket(market string) (string, float64, bool) {
+	match := overUnderMarket.FindStringSubmatch(market)
+
+	if match == nil {
+		return "", 0, false
+	}
+
+	n, err := strconv.ParseUint(match[1], 10, 32)
+
+	if err != nil || n%10 != 5 {
+		return "", 0, false
+	}
+
+	switch match[2] {
+	case CornersSuffix:
+		return Corners, float64(n) / 10, true
+	case CardsSuffix:
+		return Cards, float64(n) / 10, true
+	default:
+		return Goals, float64(n) / 10, true
+	}
+}
+
+// parseOverUnderTotal returns the match total for a totals market stat. Cards are the sum of yellow and red cards
+// shown to both teams.
+func parseOverUnderTotal(r *statistico.Result, stat string) (float64, error) {
+	home, away := r.GetHomeTeamStats(), r.GetAwayTeamStats()
+
+	switch stat {
+	case Goals:
+		h, a, err := parseGoalScored(r)
+		return float64(h + a), err
+	case Corners:
+		if home.GetCorners() == nil || away.GetCorners() == nil {
+			return 0, fmt.Errorf("unable to parse corners for fixture %d", r.Id)
+		}
+
+		return float64(home.GetCorners().GetValue() + away.GetCorners().GetValue()), nil
+	case Cards:
+		if home.GetYellowCards() == nil || away.GetYellowCards() == nil ||
+			home.GetRedCards() == nil || away.GetRedCards() == nil {
+			return 0, fmt.Errorf("unable to parse cards for fixture %d", r.Id)
+		}
+
+		cards := home.GetYellowCards().GetValue() + away.GetYellowCards().GetValue() +
+			home.GetRedCards().GetValue() + away.GetRedCards().GetValue()
+
+		return float64(cards), nil
+	default:
+		return 0, fmt.Errorf("stat %s is not supported", stat)
+	}
+}
+
+// getOverUnderResult settles runners in the format "Over 2.5 Goals". A line included in the runner name must match
+// the line of the market.
+func getOverUnderResult(market, runner string, total, line float64) (Result, error) {
+	parts := strings.Fields(runner)
+
+	if len(parts) == 0 {
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	if len(parts) > 1 {
+		l, err := strconv.ParseFloat(parts[1], 64)
+
+		if err != nil || l != line {
+			return Fail, returnRunnerError(market, runner)
+		}
+	}
+
+	switch parts[0] {
+	case Over:
+		return boolToResult(total > line), nil
+	case Under:
+		return boolToResult(total < line), nil
+	default:
+		return Fail, returnRunnerError(market, runner)
+	}
+}
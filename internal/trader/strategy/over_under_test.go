@@ -0,0 +1,126 @@
+package strategy
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_parseMarketResult_overUnder(t *testing.T) {
+	t.Run("settles goals, corners and cards totals markets for any line", func(t *testing.T) {
+		t.Helper()
+
+		res := newTotalsResult(4, 2, 7, 4, 3, 1)
+
+		tc := []struct {
+			Market   string
+			Runner   string
+			Expected Result
+		}{
+			{Market: "OVER_UNDER_05", Runner: "Over 0.5 Goals", Expected: Success},
+			{Market: "OVER_UNDER_25", Runner: "Under 2.5 Goals", Expected: Fail},
+			{Market: "OVER_UNDER_55", Runner: "Over 5.5 Goals", Expected: Success},
+			{Market: "OVER_UNDER_65", Runner: "Over 6.5 Goals", Expected: Fail},
+			{Market: "OVER_UNDER_65", Runner: "Under", Expected: Success},
+			{Market: "OVER_UNDER_105_CORNERS", Runner: "Over 10.5 Corners", Expected: Success},
+			{Market: "OVER_UNDER_115_CORNERS", Runner: "Under 11.5", Expected: Success},
+			{Market: "OVER_UNDER_35_CARDS", Runner: "Over 3.5 Cards", Expected: Success},
+			{Market: "OVER_UNDER_45_CARDS", Runner: "Over 4.5 Cards", Expected: Fail},
+		}
+
+		for i, c := range tc {
+			result, err := parseMarketResult(res, c.Market, c.Runner)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, result, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error instead of panicking for invalid runners and markets", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Result *statistico.Result
+			Market string
+			Runner string
+			Error  string
+		}{
+			{
+				Result: newTotalsResult(1, 1, 5, 5, 1, 1),
+				Market: "OVER_UNDER_25",
+				Runner: "",
+				Error:  "runner  not support for market OVER_UNDER_25",
+			},
+			{
+				Result: newTotalsResult(1, 1, 5, 5, 1, 1),
+				Market: "OVER_UNDER_25",
+				Runner: "Ov",
+				Error:  "runner Ov not support for market OVER_UNDER_25",
+			},
+			{
+				Result: newTotalsResult(1, 1, 5, 5, 1, 1),
+				Market: "OVER_UNDER_25",
+				Runner: "Over 3.5 Goals",
+				Error:  "runner Over 3.5 Goals not support for market OVER_UNDER_25",
+			},
+			{
+				Result: newTotalsResult(1, 1, 5, 5, 1, 1),
+				Market: "OVER_UNDER_30",
+				Runner: "Over 3 Goals",
+				Error:  "market OVER_UNDER_30 is not supported",
+			},
+			{
+				Result: newScoreResult(1, 2, 1, 1),
+				Market: "OVER_UNDER_95_CORNERS",
+				Runner: "Over 9.5 Corners",
+				Error:  "unable to parse corners for fixture 1",
+			},
+			{
+				Result: newScoreResult(1, 2, 1, 1),
+				Market: "OVER_UNDER_35_CARDS",
+				Runner: "Over 3.5 Cards",
+				Error:  "unable to parse cards for fixture 1",
+			},
+			{
+				Result: func() *statistico.Result {
+					r := newTotalsResult(1, 1, 5, 5, 1, 1)
+					r.AwayTeamStats.RedCards = nil
+					return r
+				}(),
+				Market: "OVER_UNDER_35_CARDS",
+				Runner: "Over 3.5 Cards",
+				Error:  "unable to parse cards for fixture 1",
+			},
+		}
+
+		for _, c := range tc {
+			result, err := parseMarketResult(c.Result, c.Market, c.Runner)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, Result(Fail), result)
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
+
+func newTotalsResult(homeGoals, awayGoals, homeCorners, awayCorners, homeYellow, awayRed uint32) *statistico.Result {
+	r := newScoreResult(1, 2, homeGoals, awayGoals)
+	r.HomeTeamStats = &statistico.TeamStats{
+		Corners:     &wrappers.UInt32Value{Value: homeCorners},
+		YellowCards: &wrappers.UInt32Value{Value: homeYellow},
+		RedCards:    &wrappers.UInt32Value{Value: 0},
+	}
+	r.AwayTeamStats = &statistico.TeamStats{
+		Corners:     &wrappers.UInt32Value{Value: awayCorners},
+		YellowCards: &wrappers.UInt32Value{Value: 0},
+		RedCards:    &wrappers.UInt32Value{Value: awayRed},
+	}
+	return r
+}
@@ -5,31 +5,77 @@ import (
 	"fmt"
 	"github.com/statistico/statistico-data-go-grpc-client"
 	"github.com/statistico/statistico-proto/go"
+	"strconv"
+	"strings"
 )
 
+// correctScoreMaxGoals is the highest number of goals for either team covered by a listed correct score runner.
+const correctScoreMaxGoals = 3
+
 type ResultParser interface {
 	Parse(ctx context.Context, eventID uint64, market, runner, side string) (Result, error)
 }
 
+// resultParser settles markets using the final score provided by the data service. A FixtureStatus is only
+// fetched for a fixture without a score, except when settling live trades where a status always takes precedence
+// so corrections such as awarded results are applied. Fixtures without a score or status return an error in
+// backtests and are UNSETTLED when settling live trades.
 type resultParser struct {
-	resultClient  statisticodata.ResultClient
+	resultClient statisticodata.ResultClient
+	statusReader FixtureStatusReader
+	settling     bool
 }
 
 func (r *resultParser) Parse(ctx context.Context, eventID uint64, market, runner, side string) (Result, error) {
+	if r.settling {
+		res, ok, err := r.parseStatus(eventID, market, runner)
+
+		if err != nil || ok {
+			return r.transform(side, res, err)
+		}
+	}
+
 	result, err := r.resultClient.ByID(ctx, eventID)
 
 	if err != nil {
 		return "", err
 	}
 
-	home, away, err := parseGoalScored(result)
+	if hasFinalScore(result) {
+		res, err := parseMarketResult(result, market, runner)
+		return r.transform(side, res, err)
+	}
 
-	if err != nil {
-		return "", err
+	if r.settling {
+		return Unsettled, nil
+	}
+
+	res, ok, err := r.parseStatus(eventID, market, runner)
+
+	if err != nil || ok {
+		return r.transform(side, res, err)
+	}
+
+	res, err = parseMarketResult(result, market, runner)
+
+	return r.transform(side, res, err)
+}
+
+// parseStatus settles a market using the FixtureStatus of a fixture, returning false if the fixture does not
+// have a status.
+func (r *resultParser) parseStatus(eventID uint64, market, runner string) (Result, bool, error) {
+	status, err := r.statusReader.Get(eventID)
+
+	if err != nil || status == nil {
+		return "", false, err
 	}
 
-	res, err := parseResult(market, runner, home, away)
+	res, err := parseStatusResult(status, market, runner)
 
+	return res, true, err
+}
+
+func (r *resultParser) transform(side string, res Result, err error) (Result, error) {
 	if err != nil {
 		return "", err
 	}
@@ -37,6 +83,10 @@ func (r *resultParser) Parse(ctx context.Context, eventID uint64, market, runner
 	return transformResultForSide(side, res)
 }
 
+func hasFinalScore(r *statistico.Result) bool {
+	return r.GetStats().GetHomeScore() != nil && r.GetStats().GetAwayScore() != nil
+}
+
 func parseGoalScored(r *statistico.Result) (uint32, uint32, error) {
 	if r.GetStats() == nil {
 		return 0, 0, fmt.Errorf("unable to parse match stats for fixture %d", r.Id)
@@ -55,23 +105,100 @@ func parseGoalScored(r *statistico.Result) (uint32, uint32, error) {
 	return stats.GetHomeScore().GetValue(), stats.GetAwayScore().GetValue(), nil
 }
 
+// parseHalfTimeScore parses the half time score of a Result which is provided in the format "1-0".
+func parseHalfTimeScore(r *statistico.Result) (uint32, uint32, error) {
+	score := strings.Split(r.GetStats().GetHalfTimeScore().GetValue(), "-")
+
+	if len(score) != 2 {
+		return 0, 0, fmt.Errorf("unable to parse half time score for fixture %d", r.Id)
+	}
+
+	home, err := strconv.ParseUint(strings.TrimSpace(score[0]), 10, 32)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse half time score for fixture %d", r.Id)
+	}
+
+	away, err := strconv.ParseUint(strings.TrimSpace(score[1]), 10, 32)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse half time score for fixture %d", r.Id)
+	}
+
+	return uint32(home), uint32(away), nil
+}
+
+func parseMarketResult(r *statistico.Result, market, runner string) (Result, error) {
+	if stat, line, ok := parseOverUnderMarket(market); ok && stat != Goals {
+		total, err := parseOverUnderTotal(r, stat)
+
+		if err != nil {
+			return Fail, err
+		}
+
+		return getOverUnderResult(market, runner, total, line)
+	}
+
+	home, away, err := parseGoalScored(r)
+
+	if err != nil {
+		return Fail, err
+	}
+
+	switch market {
+	case HalfTime, HalfTimeFullTime, FirstHalfGoals05, FirstHalfGoals15, FirstHalfGoals25:
+		htHome, htAway, err := parseHalfTimeScore(r)
+
+		if err != nil {
+			return Fail, err
+		}
+
+		return parseHalfTimeResult(market, runner, htHome, htAway, home, away)
+	default:
+		return parseResult(market, runner, home, away)
+	}
+}
+
+func parseHalfTimeResult(market, runner string, htHome, htAway, home, away uint32) (Result, error) {
+	switch market {
+	case HalfTime:
+		return getMatchOddsResult(market, runner, htHome, htAway)
+	case HalfTimeFullTime:
+		return getHalfTimeFullTimeResult(market, runner, htHome, htAway, home, away)
+	case FirstHalfGoals05:
+		return getOverUnderResult(market, runner, float64(htHome+htAway), 0.5)
+	case FirstHalfGoals15:
+		return getOverUnderResult(market, runner, float64(htHome+htAway), 1.5)
+	case FirstHalfGoals25:
+		return getOverUnderResult(market, runner, float64(htHome+htAway), 2.5)
+	default:
+		return Fail, fmt.Errorf("market %s is not supported", market)
+	}
+}
+
 func parseResult(market, runner string, home, away uint32) (Result, error) {
 	switch market {
+	case AsianGoalLine:
+		return getAsianGoalLineResult(market, runner, home, away)
+	case AsianHandicap:
+		return getAsianHandicapResult(market, runner, home, away)
+	case BothTeamsToScore:
+		return getBothTeamsToScoreResult(market, runner, home, away)
+	case CorrectScore:
+		return getCorrectScoreResult(market, runner, home, away)
+	case DoubleChance:
+		return getDoubleChanceResult(market, runner, home, away)
+	case DrawNoBet:
+		return getDrawNoBetResult(market, runner, home, away)
 	case MatchOdds:
 		return getMatchOddsResult(market, runner, home, away)
-	case OverUnder05:
-		return getOverUnderGoalsResult(market, runner, home, away, 0)
-	case OverUnder15:
-		return getOverUnderGoalsResult(market, runner, home, away, 1)
-	case OverUnder25:
-		return getOverUnderGoalsResult(market, runner, home, away, 2)
-	case OverUnder35:
-		return getOverUnderGoalsResult(market, runner, home, away, 3)
-	case OverUnder45:
-		return getOverUnderGoalsResult(market, runner, home, away, 4)
-	default:
-		return Fail, fmt.Errorf("market %s is not supported", market)
 	}
+
+	if stat, line, ok := parseOverUnderMarket(market); ok && stat == Goals {
+		return getOverUnderResult(market, runner, float64(home+away), line)
+	}
+
+	return Fail, fmt.Errorf("market %s is not supported", market)
 }
 
 func getMatchOddsResult(market, runner string, home, away uint32) (Result, error) {
@@ -102,48 +229,171 @@ func getMatchOddsResult(market, runner string, home, away uint32) (Result, error
 	return Fail, returnRunnerError(market, runner)
 }
 
-func getOverUnderGoalsResult(market, runner string, home, away, goals uint32) (Result, error) {
-	total := home + away
+func getBothTeamsToScoreResult(market, runner string, home, away uint32) (Result, error) {
+	if runner != Yes && runner != No {
+		return Fail, returnRunnerError(market, runner)
+	}
 
-	if runner[0:4] == Over {
-		if total > goals {
-			return Success, nil
-		}
+	if (home > 0 && away > 0) == (runner == Yes) {
+		return Success, nil
+	}
 
-		return Fail, nil
+	return Fail, nil
+}
+
+// getCorrectScoreResult settles runners in the format "2 - 1". The "Any Other" runners cover the scores of their
+// match outcome where either team scores more goals than the listed runners allow.
+func getCorrectScoreResult(market, runner string, home, away uint32) (Result, error) {
+	outcome := matchOutcome(home, away)
+	other := home > correctScoreMaxGoals || away > correctScoreMaxGoals
+
+	switch runner {
+	case AnyOtherHomeWin:
+		return boolToResult(other && outcome == Home), nil
+	case AnyOtherAwayWin:
+		return boolToResult(other && outcome == Away), nil
+	case AnyOtherDraw:
+		return boolToResult(other && outcome == Draw), nil
 	}
 
-	if runner[0:5] == Under {
-		if total <= goals {
-			return Success, nil
-		}
+	score := strings.Split(runner, "-")
 
-		return Fail, nil
+	if len(score) != 2 {
+		return Fail, returnRunnerError(market, runner)
 	}
 
-	return Fail, returnRunnerError(market, runner)
+	h, err := strconv.ParseUint(strings.TrimSpace(score[0]), 10, 32)
+
+	if err != nil {
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	a, err := strconv.ParseUint(strings.TrimSpace(score[1]), 10, 32)
+
+	if err != nil {
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	return boolToResult(uint32(h) == home && uint32(a) == away), nil
+}
+
+func getDoubleChanceResult(market, runner string, home, away uint32) (Result, error) {
+	outcome := matchOutcome(home, away)
+
+	var covered bool
+
+	switch runner {
+	case HomeOrDraw:
+		covered = outcome == Home || outcome == Draw
+	case DrawOrAway:
+		covered = outcome == Draw || outcome == Away
+	case HomeOrAway:
+		covered = outcome == Home || outcome == Away
+	default:
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	if covered {
+		return Success, nil
+	}
+
+	return Fail, nil
+}
+
+// getDrawNoBetResult returns VOID if the match is drawn as stakes are returned for both runners.
+func getDrawNoBetResult(market, runner string, home, away uint32) (Result, error) {
+	if runner != Home && runner != Away {
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	outcome := matchOutcome(home, away)
+
+	if outcome == Draw {
+		return Void, nil
+	}
+
+	if outcome == runner {
+		return Success, nil
+	}
+
+	return Fail, nil
 }
 
+// getHalfTimeFullTimeResult settles runners in the format "Home/Draw", where the first outcome is the half time
+// result and the second is the full time result.
+func getHalfTimeFullTimeResult(market, runner string, htHome, htAway, home, away uint32) (Result, error) {
+	outcomes := strings.Split(runner, "/")
+
+	if len(outcomes) != 2 || !isMatchOutcome(outcomes[0]) || !isMatchOutcome(outcomes[1]) {
+		return Fail, returnRunnerError(market, runner)
+	}
+
+	if outcomes[0] == matchOutcome(htHome, htAway) && outcomes[1] == matchOutcome(home, away) {
+		return Success, nil
+	}
+
+	return Fail, nil
+}
+
+func boolToResult(b bool) Result {
+	if b {
+		return Success
+	}
+
+	return Fail
+}
+
+func matchOutcome(home, away uint32) string {
+	if home > away {
+		return Home
+	}
+
+	if away > home {
+		return Away
+	}
+
+	return Draw
+}
+
+func isMatchOutcome(outcome string) bool {
+	return outcome == Home || outcome == Away || outcome == Draw
+}
+
+// transformResultForSide inverts the result of a Back trade for a Lay trade, so a HALF_WIN becomes a HALF_LOSE.
+// A VOID result is returned unchanged for either side.
 func transformResultForSide(side string, result Result) (Result, error) {
+	if side != Back && side != Lay {
+		return Fail, fmt.Errorf("side %s is not supported", side)
+	}
+
 	if side == Back {
 		return result, nil
 	}
 
-	if side == Lay {
-		if result == Success {
-			return Fail, nil
-		}
-
+	switch result {
+	case Success:
+		return Fail, nil
+	case Fail:
 		return Success, nil
+	case HalfWin:
+		return HalfLose, nil
+	case HalfLose:
+		return HalfWin, nil
+	default:
+		return result, nil
 	}
-
-	return Fail, fmt.Errorf("side %s is not supported", side)
 }
 
 func returnRunnerError(market, runner string) error {
 	return fmt.Errorf("runner %s not support for market %s", runner, market)
 }
 
-func NewResultParser(r statisticodata.ResultClient) ResultParser {
-	return &resultParser{resultClient: r}
+// NewResultParser returns a ResultParser for backtests.
+func NewResultParser(r statisticodata.ResultClient, s FixtureStatusReader) ResultParser {
+	return &resultParser{resultClient: r, statusReader: s}
+}
+
+// NewSettlementResultParser returns a ResultParser for settling live trades.
+func NewSettlementResultParser(r statisticodata.ResultClient, s FixtureStatusReader) ResultParser {
+	return &resultParser{resultClient: r, statusReader: s, settling: true}
 }
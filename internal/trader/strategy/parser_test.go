@@ -0,0 +1,547 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_parseMarketResult(t *testing.T) {
+	t.Run("parses result for half time markets", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Result   *statistico.Result
+			Market   string
+			Runner   string
+			Expected Result
+		}{
+			{
+				Result:   newHalfTimeResult(2, 1, "0-1"),
+				Market:   "HALF_TIME",
+				Runner:   "Away",
+				Expected: Success,
+			},
+			{
+				Result:   newHalfTimeResult(2, 1, "0-1"),
+				Market:   "HALF_TIME",
+				Runner:   "Home",
+				Expected: Fail,
+			},
+			{
+				Result:   newHalfTimeResult(2, 1, "0-1"),
+				Market:   "HALF_TIME_FULL_TIME",
+				Runner:   "Away/Home",
+				Expected: Success,
+			},
+			{
+				Result:   newHalfTimeResult(2, 2, "1-1"),
+				Market:   "HALF_TIME_FULL_TIME",
+				Runner:   "Draw/Home",
+				Expected: Fail,
+			},
+			{
+				Result:   newHalfTimeResult(2, 2, "1-1"),
+				Market:   "HALF_TIME_FULL_TIME",
+				Runner:   "Draw/Draw",
+				Expected: Success,
+			},
+			{
+				Result:   newHalfTimeResult(3, 1, "1-0"),
+				Market:   "FIRST_HALF_GOALS_05",
+				Runner:   "Over 0.5 Goals",
+				Expected: Success,
+			},
+			{
+				Result:   newHalfTimeResult(3, 1, "1-0"),
+				Market:   "FIRST_HALF_GOALS_15",
+				Runner:   "Over 1.5 Goals",
+				Expected: Fail,
+			},
+			{
+				Result:   newHalfTimeResult(3, 1, "1-1"),
+				Market:   "FIRST_HALF_GOALS_25",
+				Runner:   "Under 2.5 Goals",
+				Expected: Success,
+			},
+			{
+				Result:   newHalfTimeResult(3, 1, "1-1"),
+				Market:   "OVER_UNDER_25",
+				Runner:   "Over 2.5 Goals",
+				Expected: Success,
+			},
+		}
+
+		for i, c := range tc {
+			res, err := parseMarketResult(c.Result, c.Market, c.Runner)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if half time result cannot be parsed", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Result *statistico.Result
+			Market string
+			Runner string
+			Error  string
+		}{
+			{
+				Result: newScoreResult(1, 2, 2, 1),
+				Market: "HALF_TIME",
+				Runner: "Home",
+				Error:  "unable to parse half time score for fixture 1",
+			},
+			{
+				Result: newHalfTimeResult(2, 1, "1-x"),
+				Market: "HALF_TIME",
+				Runner: "Home",
+				Error:  "unable to parse half time score for fixture 1",
+			},
+			{
+				Result: newHalfTimeResult(2, 1, "1-0"),
+				Market: "HALF_TIME_FULL_TIME",
+				Runner: "Home",
+				Error:  "runner Home not support for market HALF_TIME_FULL_TIME",
+			},
+		}
+
+		for _, c := range tc {
+			res, err := parseMarketResult(c.Result, c.Market, c.Runner)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, Result(Fail), res)
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
+
+func newHalfTimeResult(homeGoals, awayGoals uint32, halfTime string) *statistico.Result {
+	r := newScoreResult(1, 2, homeGoals, awayGoals)
+	r.Stats.HalfTimeScore = &wrappers.StringValue{Value: halfTime}
+	return r
+}
+
+func Test_parseResult(t *testing.T) {
+	t.Run("parses result for both teams to score, double chance and draw no bet markets", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Market   string
+			Runner   string
+			Home     uint32
+			Away     uint32
+			Expected Result
+		}{
+			{Market: "BOTH_TEAMS_TO_SCORE", Runner: "Yes", Home: 2, Away: 1, Expected: Success},
+			{Market: "BOTH_TEAMS_TO_SCORE", Runner: "Yes", Home: 2, Away: 0, Expected: Fail},
+			{Market: "BOTH_TEAMS_TO_SCORE", Runner: "No", Home: 0, Away: 0, Expected: Success},
+			{Market: "BOTH_TEAMS_TO_SCORE", Runner: "No", Home: 1, Away: 1, Expected: Fail},
+			{Market: "DOUBLE_CHANCE", Runner: "Home or Draw", Home: 1, Away: 1, Expected: Success},
+			{Market: "DOUBLE_CHANCE", Runner: "Home or Draw", Home: 0, Away: 1, Expected: Fail},
+			{Market: "DOUBLE_CHANCE", Runner: "Draw or Away", Home: 0, Away: 1, Expected: Success},
+			{Market: "DOUBLE_CHANCE", Runner: "Draw or Away", Home: 2, Away: 1, Expected: Fail},
+			{Market: "DOUBLE_CHANCE", Runner: "Home or Away", Home: 2, Away: 1, Expected: Success},
+			{Market: "DOUBLE_CHANCE", Runner: "Home or Away", Home: 2, Away: 2, Expected: Fail},
+			{Market: "DRAW_NO_BET", Runner: "Home", Home: 2, Away: 1, Expected: Success},
+			{Market: "DRAW_NO_BET", Runner: "Home", Home: 0, Away: 1, Expected: Fail},
+			{Market: "DRAW_NO_BET", Runner: "Away", Home: 0, Away: 1, Expected: Success},
+			{Market: "DRAW_NO_BET", Runner: "Away", Home: 1, Away: 1, Expected: Void},
+		}
+
+		for i, c := range tc {
+			res, err := parseResult(c.Market, c.Runner, c.Home, c.Away)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if runner is not supported for market", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Market string
+			Runner string
+		}{
+			{Market: "BOTH_TEAMS_TO_SCORE", Runner: "Home"},
+			{Market: "DOUBLE_CHANCE", Runner: "Draw"},
+			{Market: "DRAW_NO_BET", Runner: "Draw"},
+		}
+
+		for _, c := range tc {
+			_, err := parseResult(c.Market, c.Runner, 1, 1)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, returnRunnerError(c.Market, c.Runner).Error(), err.Error())
+		}
+	})
+}
+
+func Test_transformResultForSide(t *testing.T) {
+	t.Run("inverts result for lay side and returns void results unchanged", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Side     string
+			Result   Result
+			Expected Result
+		}{
+			{Side: "BACK", Result: Success, Expected: Success},
+			{Side: "BACK", Result: Fail, Expected: Fail},
+			{Side: "LAY", Result: Success, Expected: Fail},
+			{Side: "LAY", Result: Fail, Expected: Success},
+			{Side: "BACK", Result: Void, Expected: Void},
+			{Side: "LAY", Result: Void, Expected: Void},
+			{Side: "BACK", Result: HalfWin, Expected: HalfWin},
+			{Side: "LAY", Result: HalfWin, Expected: HalfLose},
+			{Side: "LAY", Result: HalfLose, Expected: HalfWin},
+		}
+
+		for _, c := range tc {
+			res, err := transformResultForSide(c.Side, c.Result)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, c.Expected, res)
+		}
+	})
+
+	t.Run("returns an error if side is not supported", func(t *testing.T) {
+		t.Helper()
+
+		_, err := transformResultForSide("INVALID", Void)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "side INVALID is not supported", err.Error())
+	})
+}
+
+func Test_getCorrectScoreResult(t *testing.T) {
+	t.Run("settles correct score runners", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Runner   string
+			Home     uint32
+			Away     uint32
+			Expected Result
+		}{
+			{Runner: "2 - 1", Home: 2, Away: 1, Expected: Success},
+			{Runner: "2 - 1", Home: 1, Away: 2, Expected: Fail},
+			{Runner: "0-0", Home: 0, Away: 0, Expected: Success},
+			{Runner: "Any Other Home Win", Home: 4, Away: 1, Expected: Success},
+			{Runner: "Any Other Home Win", Home: 3, Away: 1, Expected: Fail},
+			{Runner: "Any Other Home Win", Home: 1, Away: 4, Expected: Fail},
+			{Runner: "Any Other Away Win", Home: 1, Away: 4, Expected: Success},
+			{Runner: "Any Other Away Win", Home: 0, Away: 3, Expected: Fail},
+			{Runner: "Any Other Draw", Home: 4, Away: 4, Expected: Success},
+			{Runner: "Any Other Draw", Home: 3, Away: 3, Expected: Fail},
+		}
+
+		for i, c := range tc {
+			res, err := getCorrectScoreResult("CORRECT_SCORE", c.Runner, c.Home, c.Away)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+		}
+	})
+
+	t.Run("lay correct score trades are settled using the inverted result", func(t *testing.T) {
+		t.Helper()
+
+		res, err := parseResult("CORRECT_SCORE", "1 - 1", 2, 0)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		res, err = transformResultForSide("LAY", res)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, Result(Success), res)
+	})
+
+	t.Run("returns an error if runner is not supported", func(t *testing.T) {
+		t.Helper()
+
+		tc := []string{"Home", "1 - x", "1 - 1 - 1"}
+
+		for _, runner := range tc {
+			_, err := getCorrectScoreResult("CORRECT_SCORE", runner, 1, 1)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, "runner "+runner+" not support for market CORRECT_SCORE", err.Error())
+		}
+	})
+}
+
+func TestResultParser_Parse(t *testing.T) {
+	t.Run("parses result using the final score without fetching the fixture status", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewResultParser(client, statuses)
+
+		ctx := context.Background()
+
+		client.On("ByID", ctx, uint64(1928)).Return(newScoreResult(1, 2, 2, 1), nil)
+
+		res, err := parser.Parse(ctx, 1928, "MATCH_ODDS", "Home", "BACK")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, Result("SUCCESS"), res)
+		client.AssertExpectations(t)
+		statuses.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("uses the fixture status if result does not contain a score", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewResultParser(client, statuses)
+
+		ctx := context.Background()
+
+		home := uint32(0)
+		away := uint32(3)
+
+		client.On("ByID", ctx, uint64(1928)).Return(newUnscoredProtoResult(), nil)
+		statuses.On("Get", uint64(1928)).
+			Return(&FixtureStatus{FixtureID: 1928, Status: "AWARDED", HomeScore: &home, AwayScore: &away}, nil)
+
+		res, err := parser.Parse(ctx, 1928, "MATCH_ODDS", "Away", "BACK")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, Result("SUCCESS"), res)
+		client.AssertExpectations(t)
+		statuses.AssertExpectations(t)
+	})
+
+	t.Run("returns an error if result does not contain a score and fixture does not have a status", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewResultParser(client, statuses)
+
+		ctx := context.Background()
+
+		client.On("ByID", ctx, uint64(1928)).Return(newUnscoredProtoResult(), nil)
+		statuses.On("Get", uint64(1928)).Return(nil, nil)
+
+		_, err := parser.Parse(ctx, 1928, "MATCH_ODDS", "Home", "BACK")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "unable to parse away team goals for fixture 1928", err.Error())
+		statuses.AssertExpectations(t)
+	})
+
+	t.Run("returns unsettled when settling a trade for a fixture without a score or status", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewSettlementResultParser(client, statuses)
+
+		ctx := context.Background()
+
+		statuses.On("Get", uint64(1928)).Return(nil, nil)
+		client.On("ByID", ctx, uint64(1928)).Return(newUnscoredProtoResult(), nil)
+
+		res, err := parser.Parse(ctx, 1928, "MATCH_ODDS", "Home", "BACK")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, Result("UNSETTLED"), res)
+		statuses.AssertExpectations(t)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("uses the final score when settling a trade for a fixture without a status", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewSettlementResultParser(client, statuses)
+
+		ctx := context.Background()
+
+		statuses.On("Get", uint64(1928)).Return(nil, nil)
+		client.On("ByID", ctx, uint64(1928)).Return(newScoreResult(1, 2, 2, 1), nil)
+
+		res, err := parser.Parse(ctx, 1928, "MATCH_ODDS", "Home", "LAY")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, Result("FAIL"), res)
+		statuses.AssertExpectations(t)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("returns void for postponed and abandoned fixtures", func(t *testing.T) {
+		t.Helper()
+
+		for _, status := range []string{"POSTPONED", "ABANDONED"} {
+			client := new(m.ResultClient)
+			statuses := new(MockFixtureStatusReader)
+			parser := NewSettlementResultParser(client, statuses)
+
+			statuses.On("Get", uint64(1928)).Return(&FixtureStatus{FixtureID: 1928, Status: status}, nil)
+
+			res, err := parser.Parse(context.Background(), 1928, "MATCH_ODDS", "Home", "LAY")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, Result("VOID"), res)
+			client.AssertNotCalled(t, "ByID", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("uses the official score for awarded fixtures", func(t *testing.T) {
+		t.Helper()
+
+		home := uint32(3)
+		away := uint32(0)
+
+		tc := []struct {
+			Market   string
+			Runner   string
+			Expected Result
+		}{
+			{Market: "MATCH_ODDS", Runner: "Away", Expected: "FAIL"},
+			{Market: "CORRECT_SCORE", Runner: "3 - 0", Expected: "SUCCESS"},
+			{Market: "OVER_UNDER_25", Runner: "Over 2.5 Goals", Expected: "SUCCESS"},
+			{Market: "HALF_TIME", Runner: "Home", Expected: "VOID"},
+			{Market: "OVER_UNDER_95_CORNERS", Runner: "Over 9.5 Corners", Expected: "VOID"},
+		}
+
+		for i, c := range tc {
+			client := new(m.ResultClient)
+			statuses := new(MockFixtureStatusReader)
+			parser := NewSettlementResultParser(client, statuses)
+
+			status := &FixtureStatus{FixtureID: 1928, Status: "AWARDED", HomeScore: &home, AwayScore: &away}
+
+			statuses.On("Get", uint64(1928)).Return(status, nil)
+
+			res, err := parser.Parse(context.Background(), 1928, c.Market, c.Runner, "BACK")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, res, "index %d", i)
+			client.AssertNotCalled(t, "ByID", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("returns an error if awarded fixture does not have an official score", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewSettlementResultParser(client, statuses)
+
+		statuses.On("Get", uint64(1928)).Return(&FixtureStatus{FixtureID: 1928, Status: "AWARDED"}, nil)
+
+		_, err := parser.Parse(context.Background(), 1928, "MATCH_ODDS", "Home", "BACK")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "awarded fixture 1928 does not have an official score", err.Error())
+	})
+
+	t.Run("returns an error if error returned by fixture status reader", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		statuses := new(MockFixtureStatusReader)
+		parser := NewSettlementResultParser(client, statuses)
+
+		statuses.On("Get", uint64(1928)).Return(nil, errors.New("connection refused"))
+
+		_, err := parser.Parse(context.Background(), 1928, "MATCH_ODDS", "Home", "BACK")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "connection refused", err.Error())
+		client.AssertNotCalled(t, "ByID", mock.Anything, mock.Anything)
+	})
+}
+
+func newUnscoredProtoResult() *statistico.Result {
+	return &statistico.Result{
+		Id:    1928,
+		Stats: &statistico.MatchStats{HomeScore: &wrappers.UInt32Value{Value: 1}},
+	}
+}
+
+type MockFixtureStatusReader struct {
+	mock.Mock
+}
+
+func (m *MockFixtureStatusReader) Get(fixtureID uint64) (*FixtureStatus, error) {
+	args := m.Called(fixtureID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*FixtureStatus), args.Error(1)
+}
@@ -0,0 +1,248 @@
+package strategy
+
+import (
+	"database/sql"
+	"encoding/json"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"time"
+)
+
+// backtestTradeBatchSize keeps each insert of backtest trades within the Postgres parameter limit.
+const backtestTradeBatchSize = 1000
+
+type postgresBacktestWriter struct {
+	connection *sql.DB
+}
+
+func (w *postgresBacktestWriter) Insert(r *BacktestRun) error {
+	query, err := json.Marshal(r.Query)
+
+	if err != nil {
+		return err
+	}
+
+	summary, err := json.Marshal(r.Summary)
+
+	if err != nil {
+		return err
+	}
+
+	tx, err := w.connection.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(tx)
+
+	_, err = builder.
+		Insert("backtest_run").
+		Columns("id", "user_id", "query", "summary", "created_at").
+		Values(r.ID.String(), r.UserID.String(), query, summary, r.CreatedAt.Unix()).
+		Exec()
+
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := 0; i < len(r.Trades); i += backtestTradeBatchSize {
+		end := i + backtestTradeBatchSize
+
+		if end > len(r.Trades) {
+			end = len(r.Trades)
+		}
+
+		insert := builder.
+			Insert("backtest_run_trade").
+			Columns(
+				"run_id",
+				"market",
+				"runner",
+				"event_id",
+				"competition_id",
+				"season_id",
+				"event_date",
+				"exchange",
+				"price",
+				"side",
+				"result",
+				"closing_price",
+			)
+
+		for _, t := range r.Trades[i:end] {
+			insert = insert.Values(
+				r.ID.String(),
+				t.MarketName,
+				t.RunnerName,
+				t.EventID,
+				t.CompetitionID,
+				t.SeasonID,
+				t.EventDate.Unix(),
+				t.Exchange,
+				t.Price,
+				t.Side,
+				string(t.Result),
+				t.ClosingPrice,
+			)
+		}
+
+		if _, err := insert.Exec(); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+type postgresBacktestReader struct {
+	connection *sql.DB
+}
+
+func (r *postgresBacktestReader) Get(id uuid.UUID) (*BacktestRun, error) {
+	builder := queryBuilder(r.connection)
+
+	row := builder.
+		Select("id", "user_id", "query", "summary", "created_at").
+		From("backtest_run").
+		Where(sq.Eq{"id": id.String()}).
+		QueryRow()
+
+	run, err := scanBacktestRun(row)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := builder.
+		Select(
+			"market",
+			"runner",
+			"event_id",
+			"competition_id",
+			"season_id",
+			"event_date",
+			"exchange",
+			"price",
+			"side",
+			"result",
+			"closing_price",
+		).
+		From("backtest_run_trade").
+		Where(sq.Eq{"run_id": id.String()}).
+		OrderBy("event_date ASC", "event_id ASC").
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	run.Trades = []*Trade{}
+
+	for rows.Next() {
+		var t Trade
+		var date int64
+		var closingPrice sql.NullFloat64
+
+		err := rows.Scan(
+			&t.MarketName,
+			&t.RunnerName,
+			&t.EventID,
+			&t.CompetitionID,
+			&t.SeasonID,
+			&date,
+			&t.Exchange,
+			&t.Price,
+			&t.Side,
+			&t.Result,
+			&closingPrice,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		t.EventDate = time.Unix(date, 0)
+
+		if closingPrice.Valid {
+			price := float32(closingPrice.Float64)
+			t.ClosingPrice = &price
+		}
+
+		run.Trades = append(run.Trades, &t)
+	}
+
+	return run, rows.Err()
+}
+
+func (r *postgresBacktestReader) List(userID uuid.UUID) ([]*BacktestRun, error) {
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select("id", "user_id", "query", "summary", "created_at").
+		From("backtest_run").
+		Where(sq.Eq{"user_id": userID.String()}).
+		OrderBy("created_at DESC").
+		Query()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	runs := []*BacktestRun{}
+
+	for rows.Next() {
+		run, err := scanBacktestRun(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+func scanBacktestRun(row sq.RowScanner) (*BacktestRun, error) {
+	var run BacktestRun
+	var id, userID string
+	var query, summary []byte
+	var created int64
+
+	if err := row.Scan(&id, &userID, &query, &summary, &created); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(query, &run.Query); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(summary, &run.Summary); err != nil {
+		return nil, err
+	}
+
+	run.ID = uuid.MustParse(id)
+	run.UserID = uuid.MustParse(userID)
+	run.CreatedAt = time.Unix(created, 0)
+
+	return &run, nil
+}
+
+func NewPostgresBacktestWriter(connection *sql.DB) BacktestWriter {
+	return &postgresBacktestWriter{connection: connection}
+}
+
+func NewPostgresBacktestReader(connection *sql.DB) BacktestReader {
+	return &postgresBacktestReader{connection: connection}
+}
@@ -0,0 +1,136 @@
+package strategy_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/statistico/statistico-trader/internal/trader/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPostgresBacktest_Get(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"backtest_run", "backtest_run_trade"})
+	writer := strategy.NewPostgresBacktestWriter(conn)
+	reader := strategy.NewPostgresBacktestReader(conn)
+
+	t.Run("inserts and returns a backtest run including trades", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		run := newBacktestRun(uuid.New(), time.Unix(1616936636, 0))
+
+		if err := writer.Insert(run); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		fetched, err := reader.Get(run.ID)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(run.ID, fetched.ID)
+		a.Equal(run.UserID, fetched.UserID)
+		a.Equal(run.Query, fetched.Query)
+		a.Equal(run.Summary, fetched.Summary)
+		a.Equal(run.CreatedAt.Unix(), fetched.CreatedAt.Unix())
+		a.Equal(2, len(fetched.Trades))
+
+		for i, tr := range fetched.Trades {
+			a.Equal(run.Trades[i].EventID, tr.EventID)
+			a.Equal(run.Trades[i].EventDate.Unix(), tr.EventDate.Unix())
+			a.Equal(run.Trades[i].Price, tr.Price)
+			a.Equal(run.Trades[i].Result, tr.Result)
+			a.Equal(run.Trades[i].ClosingPrice, tr.ClosingPrice)
+		}
+	})
+
+	t.Run("returns nil if backtest run does not exist", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		run, err := reader.Get(uuid.New())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, run)
+	})
+}
+
+func TestPostgresBacktest_List(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"backtest_run", "backtest_run_trade"})
+	writer := strategy.NewPostgresBacktestWriter(conn)
+	reader := strategy.NewPostgresBacktestReader(conn)
+
+	t.Run("returns backtest runs for a user without trades most recent first", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		userID := uuid.New()
+
+		runs := []*strategy.BacktestRun{
+			newBacktestRun(userID, time.Unix(1616936636, 0)),
+			newBacktestRun(uuid.New(), time.Unix(1616936700, 0)),
+			newBacktestRun(userID, time.Unix(1616936800, 0)),
+		}
+
+		for _, r := range runs {
+			if err := writer.Insert(r); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		fetched, err := reader.List(userID)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(2, len(fetched))
+		a.Equal(runs[2].ID, fetched[0].ID)
+		a.Equal(runs[0].ID, fetched[1].ID)
+		a.Nil(fetched[0].Trades)
+		a.Equal(runs[2].Summary, fetched[0].Summary)
+	})
+}
+
+func newBacktestRun(userID uuid.UUID, created time.Time) *strategy.BacktestRun {
+	min := float32(1.50)
+	closing := float32(1.90)
+
+	trades := []*strategy.Trade{
+		newSummaryTrade(1, 8, 17420, "BACK", 1.95, strategy.Success),
+		newSummaryTrade(2, 8, 17420, "BACK", 2.50, strategy.Fail),
+	}
+
+	trades[0].ClosingPrice = &closing
+
+	summary, err := strategy.NewSummary(trades, strategy.Commission{}, userID.String())
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &strategy.BacktestRun{
+		ID:     uuid.New(),
+		UserID: userID,
+		Query: &strategy.BuilderQuery{
+			Market:         "MATCH_ODDS",
+			Runner:         "Home",
+			MinOdds:        &min,
+			Side:           "BACK",
+			CompetitionIDs: []uint64{8},
+			SeasonIDs:      []uint64{},
+		},
+		Summary:   summary,
+		Trades:    trades,
+		CreatedAt: created,
+	}
+}
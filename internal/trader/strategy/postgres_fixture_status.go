@@ -0,0 +1,65 @@
+package strategy
+
+import (
+	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"time"
+)
+
+type postgresFixtureStatusReader struct {
+	connection *sql.DB
+}
+
+func (r *postgresFixtureStatusReader) Get(fixtureID uint64) (*FixtureStatus, error) {
+	builder := queryBuilder(r.connection)
+
+	var s FixtureStatus
+	var updated int64
+
+	err := builder.
+		Select("fixture_id", "status", "home_score", "away_score", "updated_at").
+		From("fixture_status").
+		Where(sq.Eq{"fixture_id": fixtureID}).
+		QueryRow().
+		Scan(&s.FixtureID, &s.Status, &s.HomeScore, &s.AwayScore, &updated)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.UpdatedAt = time.Unix(updated, 0)
+
+	return &s, nil
+}
+
+type postgresFixtureStatusWriter struct {
+	connection *sql.DB
+}
+
+func (w *postgresFixtureStatusWriter) Upsert(s *FixtureStatus) error {
+	builder := queryBuilder(w.connection)
+
+	_, err := builder.
+		Insert("fixture_status").
+		Columns("fixture_id", "status", "home_score", "away_score", "updated_at").
+		Values(s.FixtureID, s.Status, s.HomeScore, s.AwayScore, s.UpdatedAt.Unix()).
+		Suffix(
+			"ON CONFLICT (fixture_id) DO UPDATE SET status = EXCLUDED.status, home_score = EXCLUDED.home_score, " +
+				"away_score = EXCLUDED.away_score, updated_at = EXCLUDED.updated_at",
+		).
+		Exec()
+
+	return err
+}
+
+func NewPostgresFixtureStatusReader(connection *sql.DB) FixtureStatusReader {
+	return &postgresFixtureStatusReader{connection: connection}
+}
+
+func NewPostgresFixtureStatusWriter(connection *sql.DB) FixtureStatusWriter {
+	return &postgresFixtureStatusWriter{connection: connection}
+}
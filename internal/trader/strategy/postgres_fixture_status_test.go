@@ -0,0 +1,62 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/statistico/statistico-trader/internal/trader/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPostgresFixtureStatus_Upsert(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"fixture_status"})
+	writer := strategy.NewPostgresFixtureStatusWriter(conn)
+	reader := strategy.NewPostgresFixtureStatusReader(conn)
+
+	t.Run("inserts and updates fixture status", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		status := &strategy.FixtureStatus{
+			FixtureID: 1928,
+			Status:    "POSTPONED",
+			UpdatedAt: time.Unix(1616936636, 0),
+		}
+
+		if err := writer.Upsert(status); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		home := uint32(3)
+		away := uint32(0)
+
+		status.Status = "AWARDED"
+		status.HomeScore = &home
+		status.AwayScore = &away
+
+		if err := writer.Upsert(status); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		fetched, err := reader.Get(1928)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, status, fetched)
+	})
+
+	t.Run("returns nil if fixture does not have a status", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		fetched, err := reader.Get(1928)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, fetched)
+	})
+}
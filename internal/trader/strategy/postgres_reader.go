@@ -50,19 +50,50 @@ func (r *postgresReader) Get(q *ReaderQuery) ([]*Strategy, error) {
 			&s.StakingPlan,
 			&created,
 			&updated,
+			&s.TradeWindow,
 		)
 
 		if err != nil {
 			return st, err
 		}
 
-		rf, err := r.fetchResultFilters(id)
+		rf, err := r.fetchResultFilters(id, nil)
 
 		if err != nil {
 			return st, err
 		}
 
-		sf, err := r.fetchStatFilters(id)
+		sf, err := r.fetchStatFilters(id, nil)
+
+		if err != nil {
+			return st, err
+		}
+
+		hf, err := r.fetchHeadToHeadFilters(id, nil)
+
+		if err != nil {
+			return st, err
+		}
+
+		lf, err := r.fetchLeagueTableFilters(id, nil)
+
+		if err != nil {
+			return st, err
+		}
+
+		cf, err := r.fetchComparativeStatFilters(id, nil)
+
+		if err != nil {
+			return st, err
+		}
+
+		scf, err := r.fetchScheduleFilters(id, nil)
+
+		if err != nil {
+			return st, err
+		}
+
+		fg, err := r.fetchFilterGroups(id, nil)
 
 		if err != nil {
 			return st, err
@@ -79,6 +110,11 @@ func (r *postgresReader) Get(q *ReaderQuery) ([]*Strategy, error) {
 		s.CompetitionIDs = ids
 		s.ResultFilters = rf
 		s.StatFilters = sf
+		s.HeadToHeadFilters = hf
+		s.LeagueTableFilters = lf
+		s.ComparativeStatFilters = cf
+		s.ScheduleFilters = scf
+		s.FilterGroups = fg
 		s.CreatedAt = time.Unix(created, 0)
 		s.UpdatedAt = time.Unix(updated, 0)
 
@@ -88,7 +124,89 @@ func (r *postgresReader) Get(q *ReaderQuery) ([]*Strategy, error) {
 	return st, nil
 }
 
-func (r *postgresReader) fetchResultFilters(id string) ([]*ResultFilter, error) {
+// fetchFilterGroups returns the filter groups belonging to a parent group, or the top level filter groups for a
+// strategy if parentID is nil, including the filters and nested groups of each group.
+func (r *postgresReader) fetchFilterGroups(id string, parentID *string) ([]*FilterGroup, error) {
+	groups := []*FilterGroup{}
+
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select("id", "operator").
+		From("strategy_filter_group").
+		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("parent_id", parentID)).
+		OrderBy("position ASC").
+		Query()
+
+	if err != nil {
+		return groups, err
+	}
+
+	defer rows.Close()
+
+	ids := []string{}
+
+	for rows.Next() {
+		var groupID string
+		var g FilterGroup
+
+		if err := rows.Scan(&groupID, &g.Operator); err != nil {
+			return groups, err
+		}
+
+		ids = append(ids, groupID)
+		groups = append(groups, &g)
+	}
+
+	for i, g := range groups {
+		g.ResultFilters, err = r.fetchResultFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.StatFilters, err = r.fetchStatFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.HeadToHeadFilters, err = r.fetchHeadToHeadFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.LeagueTableFilters, err = r.fetchLeagueTableFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.ComparativeStatFilters, err = r.fetchComparativeStatFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.ScheduleFilters, err = r.fetchScheduleFilters(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+
+		g.Groups, err = r.fetchFilterGroups(id, &ids[i])
+
+		if err != nil {
+			return groups, err
+		}
+	}
+
+	return groups, nil
+}
+
+func (r *postgresReader) fetchResultFilters(id string, groupID *string) ([]*ResultFilter, error) {
 	filters := []*ResultFilter{}
 
 	builder := queryBuilder(r.connection)
@@ -99,9 +217,13 @@ func (r *postgresReader) fetchResultFilters(id string) ([]*ResultFilter, error)
 			"result",
 			"games",
 			"venue",
+			"lookback",
+			"min_games",
+			"period",
 		).
 		From("strategy_result_filter").
 		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
 		Query()
 
 	if err != nil {
@@ -118,6 +240,9 @@ func (r *postgresReader) fetchResultFilters(id string) ([]*ResultFilter, error)
 			&f.Result,
 			&f.Games,
 			&f.Venue,
+			&f.Lookback,
+			&f.MinGames,
+			&f.Period,
 		)
 
 		if err != nil {
@@ -130,7 +255,7 @@ func (r *postgresReader) fetchResultFilters(id string) ([]*ResultFilter, error)
 	return filters, nil
 }
 
-func (r *postgresReader) fetchStatFilters(id string) ([]*StatFilter, error) {
+func (r *postgresReader) fetchStatFilters(id string, groupID *string) ([]*StatFilter, error) {
 	filters := []*StatFilter{}
 
 	builder := queryBuilder(r.connection)
@@ -145,9 +270,14 @@ func (r *postgresReader) fetchStatFilters(id string) ([]*StatFilter, error) {
 			"games",
 			"value",
 			"venue",
+			"lookback",
+			"min_games",
+			"threshold",
+			"decay",
 		).
 		From("strategy_stat_filter").
 		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
 		Query()
 
 	if err != nil {
@@ -168,6 +298,220 @@ func (r *postgresReader) fetchStatFilters(id string) ([]*StatFilter, error) {
 			&f.Games,
 			&f.Value,
 			&f.Venue,
+			&f.Lookback,
+			&f.MinGames,
+			&f.Threshold,
+			&f.Decay,
+		)
+
+		if err != nil {
+			return filters, err
+		}
+
+		filters = append(filters, &f)
+	}
+
+	return filters, nil
+}
+
+func (r *postgresReader) fetchHeadToHeadFilters(id string, groupID *string) ([]*HeadToHeadFilter, error) {
+	filters := []*HeadToHeadFilter{}
+
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select(
+			"team",
+			"games",
+			"venue",
+			"result",
+			"stat",
+			"action",
+			"measure",
+			"metric",
+			"value",
+			"threshold",
+			"decay",
+		).
+		From("strategy_head_to_head_filter").
+		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
+		Query()
+
+	if err != nil {
+		return filters, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var f HeadToHeadFilter
+
+		err := rows.Scan(
+			&f.Team,
+			&f.Games,
+			&f.Venue,
+			&f.Result,
+			&f.Stat,
+			&f.Action,
+			&f.Measure,
+			&f.Metric,
+			&f.Value,
+			&f.Threshold,
+			&f.Decay,
+		)
+
+		if err != nil {
+			return filters, err
+		}
+
+		filters = append(filters, &f)
+	}
+
+	return filters, nil
+}
+
+func (r *postgresReader) fetchLeagueTableFilters(id string, groupID *string) ([]*LeagueTableFilter, error) {
+	filters := []*LeagueTableFilter{}
+
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select(
+			"team",
+			"measure",
+			"metric",
+			"value",
+		).
+		From("strategy_league_table_filter").
+		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
+		Query()
+
+	if err != nil {
+		return filters, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var f LeagueTableFilter
+
+		err := rows.Scan(
+			&f.Team,
+			&f.Measure,
+			&f.Metric,
+			&f.Value,
+		)
+
+		if err != nil {
+			return filters, err
+		}
+
+		filters = append(filters, &f)
+	}
+
+	return filters, nil
+}
+
+func (r *postgresReader) fetchComparativeStatFilters(id string, groupID *string) ([]*ComparativeStatFilter, error) {
+	filters := []*ComparativeStatFilter{}
+
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select(
+			"stat",
+			"home_action",
+			"home_venue",
+			"away_action",
+			"away_venue",
+			"games",
+			"lookback",
+			"min_games",
+			"measure",
+			"threshold_metric",
+			"threshold",
+			"decay",
+			"comparison",
+			"metric",
+			"value",
+		).
+		From("strategy_comparative_stat_filter").
+		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
+		Query()
+
+	if err != nil {
+		return filters, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var f ComparativeStatFilter
+
+		err := rows.Scan(
+			&f.Stat,
+			&f.HomeAction,
+			&f.HomeVenue,
+			&f.AwayAction,
+			&f.AwayVenue,
+			&f.Games,
+			&f.Lookback,
+			&f.MinGames,
+			&f.Measure,
+			&f.ThresholdMetric,
+			&f.Threshold,
+			&f.Decay,
+			&f.Comparison,
+			&f.Metric,
+			&f.Value,
+		)
+
+		if err != nil {
+			return filters, err
+		}
+
+		filters = append(filters, &f)
+	}
+
+	return filters, nil
+}
+
+func (r *postgresReader) fetchScheduleFilters(id string, groupID *string) ([]*ScheduleFilter, error) {
+	filters := []*ScheduleFilter{}
+
+	builder := queryBuilder(r.connection)
+
+	rows, err := builder.
+		Select(
+			"team",
+			"measure",
+			"days",
+			"metric",
+			"value",
+		).
+		From("strategy_schedule_filter").
+		Where(sq.Eq{"strategy_id": id}).
+		Where(groupCondition("group_id", groupID)).
+		Query()
+
+	if err != nil {
+		return filters, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var f ScheduleFilter
+
+		err := rows.Scan(
+			&f.Team,
+			&f.Measure,
+			&f.Days,
+			&f.Metric,
+			&f.Value,
 		)
 
 		if err != nil {
@@ -185,6 +529,10 @@ func buildReaderQuery(db *sql.DB, q *ReaderQuery) sq.SelectBuilder {
 
 	query := builder.Select("strategy.*").From("strategy")
 
+	if q.ID != nil {
+		query = query.Where(sq.Eq{"id": q.ID.String()})
+	}
+
 	if q.UserID != nil {
 		query = query.Where(sq.Eq{"user_id": q.UserID.String()})
 	}
@@ -240,6 +588,14 @@ func buildReaderQuery(db *sql.DB, q *ReaderQuery) sq.SelectBuilder {
 	return query
 }
 
+func groupCondition(column string, groupID *string) sq.Eq {
+	if groupID == nil {
+		return sq.Eq{column: nil}
+	}
+
+	return sq.Eq{column: *groupID}
+}
+
 func queryBuilder(c *sql.DB) sq.StatementBuilderType {
 	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(c)
 }
@@ -9,7 +9,7 @@ import (
 )
 
 func TestStrategyReader_Get(t *testing.T) {
-	conn, cleanUp := test.GetConnection(t, []string{"strategy", "strategy_result_filter", "strategy_stat_filter"})
+	conn, cleanUp := test.GetConnection(t, []string{"strategy", "strategy_result_filter", "strategy_stat_filter", "strategy_filter_group", "strategy_head_to_head_filter", "strategy_league_table_filter", "strategy_comparative_stat_filter", "strategy_schedule_filter"})
 	writer := strategy.NewPostgresWriter(conn)
 	reader := strategy.NewPostgresReader(conn)
 
@@ -98,6 +98,37 @@ func TestStrategyReader_Get(t *testing.T) {
 		assertStrategy(t, st[2].Strategy, s[1])
 	})
 
+	t.Run("strategies can be filtered by ID", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		st := []struct {
+			Strategy *strategy.Strategy
+		}{
+			{
+				newStrategy("Strategy A", "First Strategy", uuid.New(), nil, nil, "MATCH_ODDS", "Home", "BACK", "ACTIVE", "PUBLIC", []uint64{8, 12}),
+			},
+			{
+				newStrategy("Strategy B", "Second Strategy", uuid.New(), nil, nil, "MATCH_ODDS", "Home", "BACK", "ACTIVE", "PUBLIC", []uint64{8, 12}),
+			},
+		}
+
+		for _, s := range st {
+			if err := writer.Insert(s.Strategy); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		s, err := reader.Get(&strategy.ReaderQuery{ID: &st[1].Strategy.ID})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(s))
+		assertStrategy(t, st[1].Strategy, s[0])
+	})
+
 	t.Run("strategies can be filtered by visibility", func(t *testing.T) {
 		t.Helper()
 		defer cleanUp()
@@ -479,6 +510,89 @@ func TestStrategyReader_Get(t *testing.T) {
 	})
 }
 
+func TestStrategyReader_Get_FilterGroups(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"strategy", "strategy_result_filter", "strategy_stat_filter", "strategy_filter_group", "strategy_head_to_head_filter", "strategy_league_table_filter", "strategy_comparative_stat_filter", "strategy_schedule_filter"})
+	writer := strategy.NewPostgresWriter(conn)
+	reader := strategy.NewPostgresReader(conn)
+
+	t.Run("returns nested filter groups associated to a strategy", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		st := newStrategy("Strategy A", "First Strategy", uuid.New(), nil, nil, "MATCH_ODDS", "Home", "BACK", "ACTIVE", "PUBLIC", []uint64{8})
+
+		st.FilterGroups = []*strategy.FilterGroup{
+			{
+				Operator: "OR",
+				ResultFilters: []*strategy.ResultFilter{
+					{
+						Team:   "HOME_TEAM",
+						Result: "WIN",
+						Games:  3,
+						Venue:  "HOME_AWAY",
+					},
+				},
+				StatFilters: []*strategy.StatFilter{},
+				HeadToHeadFilters: []*strategy.HeadToHeadFilter{
+					{
+						Team:   "AWAY_TEAM",
+						Games:  2,
+						Venue:  "HOME_AWAY",
+						Result: "LOSE",
+					},
+				},
+				LeagueTableFilters:     []*strategy.LeagueTableFilter{},
+				ComparativeStatFilters: []*strategy.ComparativeStatFilter{},
+				ScheduleFilters:        []*strategy.ScheduleFilter{},
+				Groups: []*strategy.FilterGroup{
+					{
+						Operator:      "NOT",
+						ResultFilters: []*strategy.ResultFilter{},
+						StatFilters: []*strategy.StatFilter{
+							{
+								Stat:    "GOALS",
+								Team:    "HOME_TEAM",
+								Action:  "FOR",
+								Games:   4,
+								Measure: "AVERAGE",
+								Metric:  "GTE",
+								Value:   2.25,
+								Venue:   "HOME",
+							},
+						},
+						HeadToHeadFilters: []*strategy.HeadToHeadFilter{},
+						LeagueTableFilters: []*strategy.LeagueTableFilter{
+							{
+								Team:    "AWAY_TEAM",
+								Measure: "POSITION_FROM_BOTTOM",
+								Metric:  "LTE",
+								Value:   5,
+							},
+						},
+						ComparativeStatFilters: []*strategy.ComparativeStatFilter{},
+						ScheduleFilters:        []*strategy.ScheduleFilter{},
+						Groups:                 []*strategy.FilterGroup{},
+					},
+				},
+			},
+		}
+
+		if err := writer.Insert(st); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		s, err := reader.Get(&strategy.ReaderQuery{UserID: &st.UserID})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(s))
+		assertStrategy(t, st, s[0])
+		assert.Equal(t, st.FilterGroups, s[0].FilterGroups)
+	})
+}
+
 func assertStrategy(t *testing.T, expected, actual *strategy.Strategy) {
 	a := assert.New(t)
 
@@ -495,8 +609,13 @@ func assertStrategy(t *testing.T, expected, actual *strategy.Strategy) {
 	a.Equal(expected.Visibility, actual.Visibility)
 	a.Equal(expected.Status, actual.Status)
 	a.Equal(expected.StakingPlan, actual.StakingPlan)
+	a.Equal(expected.TradeWindow, actual.TradeWindow)
 	a.Equal(expected.ResultFilters, actual.ResultFilters)
 	a.Equal(expected.StatFilters, actual.StatFilters)
+	a.Equal(expected.HeadToHeadFilters, actual.HeadToHeadFilters)
+	a.Equal(expected.LeagueTableFilters, actual.LeagueTableFilters)
+	a.Equal(expected.ComparativeStatFilters, actual.ComparativeStatFilters)
+	a.Equal(expected.ScheduleFilters, actual.ScheduleFilters)
 	a.Equal(expected.CreatedAt.Unix(), actual.CreatedAt.Unix())
 	a.Equal(expected.UpdatedAt.Unix(), actual.UpdatedAt.Unix())
 }
@@ -52,6 +52,7 @@ func (w *PostgresWriter) Insert(s *Strategy) error {
 			"staking_plan",
 			"created_at",
 			"updated_at",
+			"trade_window",
 		).
 		Values(
 			s.ID.String(),
@@ -69,16 +70,104 @@ func (w *PostgresWriter) Insert(s *Strategy) error {
 			s.StakingPlan,
 			s.CreatedAt.Unix(),
 			s.UpdatedAt.Unix(),
+			s.TradeWindow,
 		).
 		Exec()
 
-	err = w.insertResultFilters(s.ID, s.ResultFilters)
-	err = w.insertStatFilters(s.ID, s.StatFilters)
+	if err != nil {
+		return err
+	}
+
+	if err := w.insertResultFilters(s.ID, nil, s.ResultFilters); err != nil {
+		return err
+	}
+
+	if err := w.insertStatFilters(s.ID, nil, s.StatFilters); err != nil {
+		return err
+	}
+
+	if err := w.insertHeadToHeadFilters(s.ID, nil, s.HeadToHeadFilters); err != nil {
+		return err
+	}
 
-	return err
+	if err := w.insertLeagueTableFilters(s.ID, nil, s.LeagueTableFilters); err != nil {
+		return err
+	}
+
+	if err := w.insertComparativeStatFilters(s.ID, nil, s.ComparativeStatFilters); err != nil {
+		return err
+	}
+
+	if err := w.insertScheduleFilters(s.ID, nil, s.ScheduleFilters); err != nil {
+		return err
+	}
+
+	return w.insertFilterGroups(s.ID, nil, s.FilterGroups)
 }
 
-func (w *PostgresWriter) insertResultFilters(strategyID uuid.UUID, f []*ResultFilter) error {
+// insertFilterGroups persists each FilterGroup alongside its filters before recursively persisting nested groups.
+// Filters that belong to a group reference the group id, top level strategy filters have a null group id.
+func (w *PostgresWriter) insertFilterGroups(strategyID uuid.UUID, parentID *string, groups []*FilterGroup) error {
+	builder := queryBuilder(w.connection)
+
+	for i, group := range groups {
+		id := uuid.New().String()
+
+		_, err := builder.
+			Insert("strategy_filter_group").
+			Columns(
+				"id",
+				"strategy_id",
+				"parent_id",
+				"operator",
+				"position",
+			).
+			Values(
+				id,
+				strategyID.String(),
+				parentID,
+				group.Operator,
+				i,
+			).
+			Exec()
+
+		if err != nil {
+			return err
+		}
+
+		if err := w.insertResultFilters(strategyID, &id, group.ResultFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertStatFilters(strategyID, &id, group.StatFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertHeadToHeadFilters(strategyID, &id, group.HeadToHeadFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertLeagueTableFilters(strategyID, &id, group.LeagueTableFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertComparativeStatFilters(strategyID, &id, group.ComparativeStatFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertScheduleFilters(strategyID, &id, group.ScheduleFilters); err != nil {
+			return err
+		}
+
+		if err := w.insertFilterGroups(strategyID, &id, group.Groups); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PostgresWriter) insertResultFilters(strategyID uuid.UUID, groupID *string, f []*ResultFilter) error {
 	builder := queryBuilder(w.connection)
 
 	for _, filter := range f {
@@ -86,17 +175,25 @@ func (w *PostgresWriter) insertResultFilters(strategyID uuid.UUID, f []*ResultFi
 			Insert("strategy_result_filter").
 			Columns(
 				"strategy_id",
+				"group_id",
 				"team",
 				"result",
 				"games",
 				"venue",
+				"lookback",
+				"min_games",
+				"period",
 			).
 			Values(
 				strategyID.String(),
+				groupID,
 				filter.Team,
 				filter.Result,
 				filter.Games,
 				filter.Venue,
+				filter.Lookback,
+				filter.MinGames,
+				filter.Period,
 			).
 			Exec()
 
@@ -108,7 +205,7 @@ func (w *PostgresWriter) insertResultFilters(strategyID uuid.UUID, f []*ResultFi
 	return nil
 }
 
-func (w *PostgresWriter) insertStatFilters(strategyID uuid.UUID, f []*StatFilter) error {
+func (w *PostgresWriter) insertStatFilters(strategyID uuid.UUID, groupID *string, f []*StatFilter) error {
 	builder := queryBuilder(w.connection)
 
 	for _, filter := range f {
@@ -116,6 +213,7 @@ func (w *PostgresWriter) insertStatFilters(strategyID uuid.UUID, f []*StatFilter
 			Insert("strategy_stat_filter").
 			Columns(
 				"strategy_id",
+				"group_id",
 				"stat",
 				"team",
 				"action",
@@ -124,9 +222,14 @@ func (w *PostgresWriter) insertStatFilters(strategyID uuid.UUID, f []*StatFilter
 				"games",
 				"value",
 				"venue",
+				"lookback",
+				"min_games",
+				"threshold",
+				"decay",
 			).
 			Values(
 				strategyID.String(),
+				groupID,
 				filter.Stat,
 				filter.Team,
 				filter.Action,
@@ -135,6 +238,176 @@ func (w *PostgresWriter) insertStatFilters(strategyID uuid.UUID, f []*StatFilter
 				filter.Games,
 				filter.Value,
 				filter.Venue,
+				filter.Lookback,
+				filter.MinGames,
+				filter.Threshold,
+				filter.Decay,
+			).
+			Exec()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PostgresWriter) insertHeadToHeadFilters(strategyID uuid.UUID, groupID *string, f []*HeadToHeadFilter) error {
+	builder := queryBuilder(w.connection)
+
+	for _, filter := range f {
+		_, err := builder.
+			Insert("strategy_head_to_head_filter").
+			Columns(
+				"strategy_id",
+				"group_id",
+				"team",
+				"games",
+				"venue",
+				"result",
+				"stat",
+				"action",
+				"measure",
+				"metric",
+				"value",
+				"threshold",
+				"decay",
+			).
+			Values(
+				strategyID.String(),
+				groupID,
+				filter.Team,
+				filter.Games,
+				filter.Venue,
+				filter.Result,
+				filter.Stat,
+				filter.Action,
+				filter.Measure,
+				filter.Metric,
+				filter.Value,
+				filter.Threshold,
+				filter.Decay,
+			).
+			Exec()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PostgresWriter) insertLeagueTableFilters(strategyID uuid.UUID, groupID *string, f []*LeagueTableFilter) error {
+	builder := queryBuilder(w.connection)
+
+	for _, filter := range f {
+		_, err := builder.
+			Insert("strategy_league_table_filter").
+			Columns(
+				"strategy_id",
+				"group_id",
+				"team",
+				"measure",
+				"metric",
+				"value",
+			).
+			Values(
+				strategyID.String(),
+				groupID,
+				filter.Team,
+				filter.Measure,
+				filter.Metric,
+				filter.Value,
+			).
+			Exec()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PostgresWriter) insertComparativeStatFilters(strategyID uuid.UUID, groupID *string, f []*ComparativeStatFilter) error {
+	builder := queryBuilder(w.connection)
+
+	for _, filter := range f {
+		_, err := builder.
+			Insert("strategy_comparative_stat_filter").
+			Columns(
+				"strategy_id",
+				"group_id",
+				"stat",
+				"home_action",
+				"home_venue",
+				"away_action",
+				"away_venue",
+				"games",
+				"lookback",
+				"min_games",
+				"measure",
+				"threshold_metric",
+				"threshold",
+				"decay",
+				"comparison",
+				"metric",
+				"value",
+			).
+			Values(
+				strategyID.String(),
+				groupID,
+				filter.Stat,
+				filter.HomeAction,
+				filter.HomeVenue,
+				filter.AwayAction,
+				filter.AwayVenue,
+				filter.Games,
+				filter.Lookback,
+				filter.MinGames,
+				filter.Measure,
+				filter.ThresholdMetric,
+				filter.Threshold,
+				filter.Decay,
+				filter.Comparison,
+				filter.Metric,
+				filter.Value,
+			).
+			Exec()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *PostgresWriter) insertScheduleFilters(strategyID uuid.UUID, groupID *string, f []*ScheduleFilter) error {
+	builder := queryBuilder(w.connection)
+
+	for _, filter := range f {
+		_, err := builder.
+			Insert("strategy_schedule_filter").
+			Columns(
+				"strategy_id",
+				"group_id",
+				"team",
+				"measure",
+				"days",
+				"metric",
+				"value",
+			).
+			Values(
+				strategyID.String(),
+				groupID,
+				filter.Team,
+				filter.Measure,
+				filter.Days,
+				filter.Metric,
+				filter.Value,
 			).
 			Exec()
 
@@ -10,7 +10,7 @@ import (
 )
 
 func TestPostgresWriter_Insert(t *testing.T) {
-	conn, cleanUp := test.GetConnection(t, []string{"strategy", "strategy_result_filter", "strategy_stat_filter"})
+	conn, cleanUp := test.GetConnection(t, []string{"strategy", "strategy_result_filter", "strategy_stat_filter", "strategy_head_to_head_filter", "strategy_league_table_filter", "strategy_comparative_stat_filter", "strategy_schedule_filter"})
 	repo := strategy.NewPostgresWriter(conn)
 
 	t.Run("increases tables counts", func(t *testing.T) {
@@ -135,6 +135,11 @@ func newStrategy(
 		Side:           side,
 		Visibility:     vis,
 		Status:         status,
+		TradeWindow: strategy.TradeWindow{
+			MaxMinutesBeforeKickoff: uint32Pointer(120),
+			Weekdays:                []string{"SATURDAY", "SUNDAY"},
+			Timezone:                "Europe/London",
+		},
 		ResultFilters: []*strategy.ResultFilter{
 			{
 				Team:   "HOME",
@@ -143,32 +148,85 @@ func newStrategy(
 				Venue:  "HOME_AWAY",
 			},
 			{
-				Team:   "AWAY",
-				Result: "LOSE",
-				Games:  3,
-				Venue:  "HOME_AWAY",
+				Team:     "AWAY",
+				Result:   "LOSE",
+				Games:    3,
+				Venue:    "HOME_AWAY",
+				Lookback: "ROLLING",
+				MinGames: 3,
+				Period:   "HALF_TIME",
 			},
 		},
 		StatFilters: []*strategy.StatFilter{
 			{
-				Stat:    "SHOTS_ON_GOAL",
-				Team:    "HOME",
-				Action:  "FOR",
-				Games:   2,
-				Measure: "TOTAL",
-				Metric:  "GTE",
-				Value:   2,
-				Venue:   "AWAY",
+				Stat:      "SHOTS_ON_GOAL",
+				Team:      "HOME",
+				Action:    "FOR",
+				Games:     2,
+				Measure:   "PERCENTAGE",
+				Metric:    "GTE",
+				Value:     60,
+				Venue:     "AWAY",
+				Threshold: 4,
 			},
 			{
+				Stat:     "GOALS",
+				Team:     "AWAY",
+				Action:   "FOR",
+				Games:    2,
+				Measure:  "TOTAL",
+				Metric:   "GTE",
+				Value:    2,
+				Venue:    "AWAY",
+				Lookback: "PREVIOUS_SEASON",
+				MinGames: 2,
+			},
+		},
+		HeadToHeadFilters: []*strategy.HeadToHeadFilter{
+			{
+				Team:    "HOME_TEAM",
+				Games:   4,
+				Venue:   "HOME",
+				Result:  "WIN_DRAW",
 				Stat:    "GOALS",
-				Team:    "AWAY",
 				Action:  "FOR",
-				Games:   2,
-				Measure: "TOTAL",
+				Measure: "AVERAGE",
+				Metric:  "GTE",
+				Value:   1.5,
+			},
+		},
+		LeagueTableFilters: []*strategy.LeagueTableFilter{
+			{
+				Team:    "HOME_TEAM",
+				Measure: "POSITION_GAP",
+				Metric:  "GTE",
+				Value:   -3,
+			},
+		},
+		ComparativeStatFilters: []*strategy.ComparativeStatFilter{
+			{
+				Stat:            "GOALS",
+				HomeAction:      "FOR",
+				HomeVenue:       "HOME",
+				AwayAction:      "AGAINST",
+				AwayVenue:       "AWAY",
+				Games:           6,
+				Lookback:        "ROLLING",
+				Measure:         "PERCENTAGE",
+				ThresholdMetric: "GTE",
+				Threshold:       1,
+				Comparison:      "DIFFERENCE",
+				Metric:          "GTE",
+				Value:           20,
+			},
+		},
+		ScheduleFilters: []*strategy.ScheduleFilter{
+			{
+				Team:    "AWAY_TEAM",
+				Measure: "MATCHES_PLAYED",
+				Days:    14,
 				Metric:  "GTE",
-				Value:   2,
-				Venue:   "AWAY",
+				Value:   4,
 			},
 		},
 		CreatedAt: time.Now(),
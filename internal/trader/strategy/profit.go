@@ -0,0 +1,34 @@
+package strategy
+
+import "fmt"
+
+// CalculateProfit returns the profit or loss of a trade settled with the result provided. The stake is the backer's
+// stake for both sides, so a losing Lay trade loses the liability of stake multiplied by price minus one. HALF_WIN
+// and HALF_LOSE results settle half of the stake and VOID results return the stake.
+func CalculateProfit(side string, price, stake float32, result Result) (float32, error) {
+	var win, lose float32
+
+	switch side {
+	case Back:
+		win, lose = stake*(price-1), stake
+	case Lay:
+		win, lose = stake, stake*(price-1)
+	default:
+		return 0, fmt.Errorf("side %s is not supported", side)
+	}
+
+	switch result {
+	case Success:
+		return win, nil
+	case HalfWin:
+		return win / 2, nil
+	case Void:
+		return 0, nil
+	case HalfLose:
+		return -lose / 2, nil
+	case Fail:
+		return -lose, nil
+	default:
+		return 0, fmt.Errorf("result %s is not supported", result)
+	}
+}
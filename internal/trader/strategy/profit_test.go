@@ -0,0 +1,60 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCalculateProfit(t *testing.T) {
+	t.Run("calculates profit for back and lay trades", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Side     string
+			Result   strategy.Result
+			Expected float32
+		}{
+			{Side: "BACK", Result: "SUCCESS", Expected: 15},
+			{Side: "BACK", Result: "HALF_WIN", Expected: 7.5},
+			{Side: "BACK", Result: "VOID", Expected: 0},
+			{Side: "BACK", Result: "HALF_LOSE", Expected: -5},
+			{Side: "BACK", Result: "FAIL", Expected: -10},
+			{Side: "LAY", Result: "SUCCESS", Expected: 10},
+			{Side: "LAY", Result: "HALF_WIN", Expected: 5},
+			{Side: "LAY", Result: "VOID", Expected: 0},
+			{Side: "LAY", Result: "HALF_LOSE", Expected: -7.5},
+			{Side: "LAY", Result: "FAIL", Expected: -15},
+		}
+
+		for i, c := range tc {
+			profit, err := strategy.CalculateProfit(c.Side, 2.5, 10, c.Result)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, profit, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if side or result is not supported", func(t *testing.T) {
+		t.Helper()
+
+		_, err := strategy.CalculateProfit("INVALID", 2.5, 10, "SUCCESS")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "side INVALID is not supported", err.Error())
+
+		_, err = strategy.CalculateProfit("BACK", 2.5, 10, "IN_PLAY")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "result IN_PLAY is not supported", err.Error())
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/statistico/statistico-data-go-grpc-client"
 	"github.com/statistico/statistico-proto/go"
-	"time"
 )
 
 type ResultFilterClassifier interface {
@@ -14,7 +13,7 @@ type ResultFilterClassifier interface {
 }
 
 type resultFilterClassifier struct {
-	resultClient statisticodata.ResultClient
+	fetcher *teamResultFetcher
 }
 
 func (r *resultFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *ResultFilter) (bool, error) {
@@ -24,21 +23,23 @@ func (r *resultFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture
 		return false, err
 	}
 
-	req := statistico.TeamResultRequest{
-		TeamId:     teamID,
-		Limit:      &wrappers.UInt64Value{Value: uint64(f.Games)},
-		DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
-		SeasonIds:  []uint64{fix.SeasonID},
-		Venue:      &wrappers.StringValue{Value: f.Venue},
-	}
-
-	results, err := r.resultClient.ByTeam(ctx, &req)
+	results, err := r.fetcher.fetch(ctx, fix, teamID, f.Games, f.Venue, f.Lookback)
 
 	if err != nil {
 		return false, err
 	}
 
+	if !meetsMinimumGames(results, f.Games, f.MinGames) {
+		return false, nil
+	}
+
 	for _, res := range results {
+		res, err := parsePeriodResult(res, f.Period)
+
+		if err != nil {
+			return false, err
+		}
+
 		if !resultMeetsCriteria(res, teamID, f.Result) {
 			return false, nil
 		}
@@ -59,6 +60,54 @@ func parseTeamID(fix *Fixture, team string) (uint64, error) {
 	return 0, fmt.Errorf("team enum %s is not supported", team)
 }
 
+// parsePeriodResult returns a copy of a Result with the final score replaced by the score for the period provided
+// so result criteria can be applied to the half time score or the score of the second half only.
+func parsePeriodResult(rs *statistico.Result, period string) (*statistico.Result, error) {
+	if period == "" || period == FullTimePeriod {
+		return rs, nil
+	}
+
+	htHome, htAway, err := parseHalfTimeScore(rs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if period == HalfTimePeriod {
+		return resultWithScore(rs, htHome, htAway), nil
+	}
+
+	if period == SecondHalfPeriod {
+		home, away, err := parseGoalScored(rs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if htHome > home || htAway > away {
+			return nil, fmt.Errorf("half time score exceeds full time score for fixture %d", rs.Id)
+		}
+
+		return resultWithScore(rs, home-htHome, away-htAway), nil
+	}
+
+	return nil, fmt.Errorf("period %s is not supported", period)
+}
+
+func resultWithScore(rs *statistico.Result, home, away uint32) *statistico.Result {
+	return &statistico.Result{
+		Id:       rs.GetId(),
+		HomeTeam: rs.GetHomeTeam(),
+		AwayTeam: rs.GetAwayTeam(),
+		Season:   rs.GetSeason(),
+		DateTime: rs.GetDateTime(),
+		Stats: &statistico.MatchStats{
+			HomeScore: &wrappers.UInt32Value{Value: home},
+			AwayScore: &wrappers.UInt32Value{Value: away},
+		},
+	}
+}
+
 func resultMeetsCriteria(rs *statistico.Result, teamID uint64, result string) bool {
 	switch result {
 	case Win:
@@ -76,6 +125,6 @@ func resultMeetsCriteria(rs *statistico.Result, teamID uint64, result string) bo
 	}
 }
 
-func NewResultFilterClassifier(c statisticodata.ResultClient) ResultFilterClassifier {
-	return &resultFilterClassifier{resultClient: c}
+func NewResultFilterClassifier(r statisticodata.ResultClient, s statisticodata.SeasonClient) ResultFilterClassifier {
+	return &resultFilterClassifier{fetcher: &teamResultFetcher{resultClient: r, seasonClient: s}}
 }
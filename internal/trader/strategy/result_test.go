@@ -146,7 +146,7 @@ func TestResultClassifier_MatchesFilter(t *testing.T) {
 
 		for index, res := range assertions {
 			client := new(m.ResultClient)
-			classifier := strategy.NewResultFilterClassifier(client)
+			classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
 
 			ctx := context.Background()
 
@@ -284,7 +284,7 @@ func TestResultClassifier_MatchesFilter(t *testing.T) {
 
 		for index, res := range assertions {
 			client := new(m.ResultClient)
-			classifier := strategy.NewResultFilterClassifier(client)
+			classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
 
 			ctx := context.Background()
 
@@ -314,7 +314,7 @@ func TestResultClassifier_MatchesFilter(t *testing.T) {
 		t.Helper()
 
 		client := new(m.ResultClient)
-		classifier := strategy.NewResultFilterClassifier(client)
+		classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
 
 		fixture := &strategy.Fixture{
 			ID:         55,
@@ -360,6 +360,274 @@ func TestResultClassifier_MatchesFilter(t *testing.T) {
 		assert.False(t, success)
 		assert.Equal(t, "invalid argument", err.Error())
 	})
+
+	t.Run("searches results across seasons using lookback provided", func(t *testing.T) {
+		t.Helper()
+
+		fixture := &strategy.Fixture{
+			ID:            55,
+			HomeTeamID:    1,
+			AwayTeamID:    2,
+			Date:          time.Unix(1584014400, 0),
+			CompetitionID: 8,
+			SeasonID:      17420,
+		}
+
+		seasons := []*statistico.Season{
+			{Id: 17420, Name: "2019/2020"},
+			{Id: 16036, Name: "2018/2019"},
+			{Id: 12962, Name: "2017/2018"},
+		}
+
+		assertions := []struct {
+			Lookback  string
+			SeasonIDs []uint64
+		}{
+			{"", []uint64{17420}},
+			{"CURRENT_SEASON", []uint64{17420}},
+			{"ROLLING", nil},
+			{"PREVIOUS_SEASON", []uint64{16036}},
+		}
+
+		for _, a := range assertions {
+			client := new(m.ResultClient)
+			seasonClient := new(m.SeasonClient)
+			classifier := strategy.NewResultFilterClassifier(client, seasonClient)
+
+			ctx := context.Background()
+
+			filter := &strategy.ResultFilter{
+				Team:     "HOME_TEAM",
+				Result:   "WIN",
+				Games:    1,
+				Venue:    "HOME_AWAY",
+				Lookback: a.Lookback,
+			}
+
+			seasonClient.On("ByCompetitionID", ctx, uint64(8), "name_asc").Return(seasons, nil)
+
+			req := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+				return assert.Equal(t, a.SeasonIDs, r.SeasonIds, "lookback %s", a.Lookback)
+			})
+
+			client.On("ByTeam", ctx, req).Return([]*statistico.Result{newProtoResult(1, 5, 2, 0)}, nil)
+
+			success, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.True(t, success)
+			client.AssertExpectations(t)
+		}
+	})
+
+	t.Run("returns false if fewer results than minimum games are returned", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
+
+		fixture := &strategy.Fixture{
+			ID:         55,
+			HomeTeamID: 1,
+			AwayTeamID: 2,
+			Date:       time.Unix(1584014400, 0),
+			SeasonID:   8,
+		}
+
+		ctx := context.Background()
+
+		filter := &strategy.ResultFilter{
+			Team:     "HOME_TEAM",
+			Result:   "WIN",
+			Games:    3,
+			Venue:    "HOME",
+			MinGames: 3,
+		}
+
+		results := []*statistico.Result{
+			newProtoResult(1, 5, 4, 0),
+			newProtoResult(1, 11, 2, 1),
+		}
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).Return(results, nil)
+
+		success, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("requires the full number of games if minimum games is not set", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
+
+		fixture := &strategy.Fixture{
+			ID:         55,
+			HomeTeamID: 1,
+			AwayTeamID: 2,
+			Date:       time.Unix(1584014400, 0),
+			SeasonID:   8,
+		}
+
+		ctx := context.Background()
+
+		filter := &strategy.ResultFilter{
+			Team:   "HOME_TEAM",
+			Result: "WIN",
+			Games:  3,
+			Venue:  "HOME",
+		}
+
+		results := []*statistico.Result{
+			newProtoResult(1, 5, 4, 0),
+			newProtoResult(1, 11, 2, 1),
+		}
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).Return(results, nil)
+
+		success, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("returns error if previous season cannot be found", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		seasonClient := new(m.SeasonClient)
+		classifier := strategy.NewResultFilterClassifier(client, seasonClient)
+
+		fixture := &strategy.Fixture{
+			ID:            55,
+			HomeTeamID:    1,
+			AwayTeamID:    2,
+			Date:          time.Unix(1584014400, 0),
+			CompetitionID: 8,
+			SeasonID:      17420,
+		}
+
+		ctx := context.Background()
+
+		filter := &strategy.ResultFilter{
+			Team:     "HOME_TEAM",
+			Result:   "WIN",
+			Games:    3,
+			Venue:    "HOME",
+			Lookback: "PREVIOUS_SEASON",
+		}
+
+		seasonClient.On("ByCompetitionID", ctx, uint64(8), "name_asc").
+			Return([]*statistico.Season{{Id: 17420, Name: "2019/2020"}}, nil)
+
+		_, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "unable to find season prior to season 17420 for competition 8", err.Error())
+		client.AssertNotCalled(t, "ByTeam")
+	})
+	t.Run("applies result criteria to the score of the period provided", func(t *testing.T) {
+		t.Helper()
+
+		fixture := &strategy.Fixture{
+			ID:         55,
+			HomeTeamID: 1,
+			AwayTeamID: 2,
+			Date:       time.Unix(1584014400, 0),
+			SeasonID:   8,
+		}
+
+		results := []*statistico.Result{
+			newHalfTimeProtoResult(1, 5, 3, 1, "0-1"),
+			newHalfTimeProtoResult(10, 1, 2, 2, "2-0"),
+			newHalfTimeProtoResult(1, 11, 1, 1, "0-1"),
+		}
+
+		tc := []struct {
+			Filter   *strategy.ResultFilter
+			Expected bool
+		}{
+			{
+				Filter:   &strategy.ResultFilter{Team: "HOME_TEAM", Result: "LOSE", Games: 3, Period: "HALF_TIME"},
+				Expected: true,
+			},
+			{
+				Filter:   &strategy.ResultFilter{Team: "HOME_TEAM", Result: "WIN", Games: 3, Period: "SECOND_HALF"},
+				Expected: true,
+			},
+			{
+				Filter:   &strategy.ResultFilter{Team: "HOME_TEAM", Result: "WIN", Games: 3, Period: "FULL_TIME"},
+				Expected: false,
+			},
+		}
+
+		for i, c := range tc {
+			client := new(m.ResultClient)
+			classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
+
+			ctx := context.Background()
+
+			client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).Return(results, nil)
+
+			success, err := classifier.MatchesFilter(ctx, fixture, c.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.Equal(t, c.Expected, success, "index %d", i)
+		}
+	})
+
+	t.Run("returns error if half time score cannot be parsed", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewResultFilterClassifier(client, new(m.SeasonClient))
+
+		fixture := &strategy.Fixture{
+			ID:         55,
+			HomeTeamID: 1,
+			AwayTeamID: 2,
+			Date:       time.Unix(1584014400, 0),
+			SeasonID:   8,
+		}
+
+		ctx := context.Background()
+
+		filter := &strategy.ResultFilter{
+			Team:   "AWAY_TEAM",
+			Result: "LOSE",
+			Games:  1,
+			Period: "HALF_TIME",
+		}
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{newProtoResult(2, 5, 1, 0)}, nil)
+
+		success, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.False(t, success)
+		assert.Equal(t, "unable to parse half time score for fixture 1", err.Error())
+	})
 }
 
 func newProtoResult(homeId, awayId uint64, homeScore, awayScore uint32) *statistico.Result {
@@ -375,3 +643,9 @@ func newProtoResult(homeId, awayId uint64, homeScore, awayScore uint32) *statist
 		AwayTeamStats: &statistico.TeamStats{Goals: &wrappers.UInt32Value{Value: awayScore}},
 	}
 }
+
+func newHalfTimeProtoResult(homeId, awayId uint64, homeScore, awayScore uint32, halfTime string) *statistico.Result {
+	r := newProtoResult(homeId, awayId, homeScore, awayScore)
+	r.Stats.HalfTimeScore = &wrappers.StringValue{Value: halfTime}
+	return r
+}
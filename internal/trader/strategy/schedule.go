@@ -0,0 +1,107 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"time"
+)
+
+type ScheduleFilterClassifier interface {
+	MatchesFilter(ctx context.Context, fix *Fixture, f *ScheduleFilter) (bool, error)
+}
+
+type scheduleFilterClassifier struct {
+	resultClient statisticodata.ResultClient
+}
+
+// MatchesFilter determines if the match schedule of a team before the Fixture matches the provided ScheduleFilter.
+// Matches in all competitions are considered. A rest days filter is not matched if a team has no previous match.
+func (s *scheduleFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *ScheduleFilter) (bool, error) {
+	teamID, err := parseTeamID(fix, f.Team)
+
+	if err != nil {
+		return false, err
+	}
+
+	switch f.Measure {
+	case RestDays:
+		days, ok, err := s.restDays(ctx, fix, teamID)
+
+		if err != nil || !ok {
+			return false, err
+		}
+
+		return meetsMetric(days, f.Metric, f.Value)
+	case RestDaysDifference:
+		opponentID, err := parseOpponentID(fix, f.Team)
+
+		if err != nil {
+			return false, err
+		}
+
+		days, ok, err := s.restDays(ctx, fix, teamID)
+
+		if err != nil || !ok {
+			return false, err
+		}
+
+		opponentDays, ok, err := s.restDays(ctx, fix, opponentID)
+
+		if err != nil || !ok {
+			return false, err
+		}
+
+		return meetsMetric(days-opponentDays, f.Metric, f.Value)
+	case MatchesPlayed:
+		if f.Days == 0 {
+			return false, fmt.Errorf("schedule filter measure %s requires days", f.Measure)
+		}
+
+		req := statistico.TeamResultRequest{
+			TeamId:     teamID,
+			DateAfter:  &wrappers.StringValue{Value: fix.Date.AddDate(0, 0, -int(f.Days)).Format(time.RFC3339)},
+			DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
+		}
+
+		results, err := s.resultClient.ByTeam(ctx, &req)
+
+		if err != nil {
+			return false, err
+		}
+
+		return meetsMetric(float32(len(results)), f.Metric, f.Value)
+	default:
+		return false, fmt.Errorf("measure %s is not supported", f.Measure)
+	}
+}
+
+// restDays returns the number of whole days between the previous match of a team and the Fixture and a bool
+// indicating whether a previous match exists.
+func (s *scheduleFilterClassifier) restDays(ctx context.Context, fix *Fixture, teamID uint64) (float32, bool, error) {
+	req := statistico.TeamResultRequest{
+		TeamId:     teamID,
+		Limit:      &wrappers.UInt64Value{Value: 1},
+		DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
+	}
+
+	results, err := s.resultClient.ByTeam(ctx, &req)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(results) == 0 || results[0].GetDateTime() == nil {
+		return 0, false, nil
+	}
+
+	previous := time.Unix(results[0].GetDateTime().GetUtc(), 0)
+
+	return float32(int(fix.Date.Sub(previous).Hours() / 24)), true, nil
+}
+
+func NewScheduleFilterClassifier(c statisticodata.ResultClient) ScheduleFilterClassifier {
+	return &scheduleFilterClassifier{resultClient: c}
+}
@@ -0,0 +1,164 @@
+package strategy_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-proto/go"
+	m "github.com/statistico/statistico-trader/internal/trader/mock"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestScheduleFilterClassifier_MatchesFilter(t *testing.T) {
+	date := time.Date(2020, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	fixture := strategy.Fixture{
+		ID:         55,
+		HomeTeamID: 1,
+		AwayTeamID: 2,
+		Date:       date,
+		SeasonID:   8,
+	}
+
+	previousResult := func(homeID, awayID uint64, daysBefore int) *statistico.Result {
+		res := newProtoResult(homeID, awayID, 1, 0)
+		res.DateTime = &statistico.Date{Utc: date.AddDate(0, 0, -daysBefore).Unix()}
+		return res
+	}
+
+	previousMatchRequest := func(teamID uint64) interface{} {
+		return mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+			return r.TeamId == teamID &&
+				r.GetLimit().GetValue() == 1 &&
+				r.GetDateBefore().GetValue() == date.Format(time.RFC3339) &&
+				r.GetSeasonIds() == nil
+		})
+	}
+
+	t.Run("returns bool if rest days match filter", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			Filter   *strategy.ScheduleFilter
+			Expected bool
+		}{
+			{&strategy.ScheduleFilter{Team: "HOME_TEAM", Measure: "REST_DAYS", Metric: "GTE", Value: 7}, true},
+			{&strategy.ScheduleFilter{Team: "AWAY_TEAM", Measure: "REST_DAYS", Metric: "GTE", Value: 4}, false},
+			{&strategy.ScheduleFilter{Team: "HOME_TEAM", Measure: "REST_DAYS_DIFFERENCE", Metric: "GTE", Value: 4}, true},
+			{&strategy.ScheduleFilter{Team: "AWAY_TEAM", Measure: "REST_DAYS_DIFFERENCE", Metric: "LTE", Value: -5}, false},
+		}
+
+		for index, a := range assertions {
+			ctx := context.Background()
+			client := new(m.ResultClient)
+			classifier := strategy.NewScheduleFilterClassifier(client)
+
+			client.On("ByTeam", ctx, previousMatchRequest(1)).Return([]*statistico.Result{previousResult(1, 10, 7)}, nil)
+			client.On("ByTeam", ctx, previousMatchRequest(2)).Return([]*statistico.Result{previousResult(11, 2, 3)}, nil)
+
+			success, err := classifier.MatchesFilter(ctx, &fixture, a.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), index)
+			}
+
+			assert.Equal(t, a.Expected, success, "index %d", index)
+		}
+	})
+
+	t.Run("returns false if team has no previous match", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := new(m.ResultClient)
+		classifier := strategy.NewScheduleFilterClassifier(client)
+
+		client.On("ByTeam", ctx, previousMatchRequest(1)).Return([]*statistico.Result{}, nil)
+
+		f := strategy.ScheduleFilter{Team: "HOME_TEAM", Measure: "REST_DAYS", Metric: "LTE", Value: 100}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
+
+	t.Run("returns bool if matches played in the days before the fixture match filter", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := new(m.ResultClient)
+		classifier := strategy.NewScheduleFilterClassifier(client)
+
+		req := mock.MatchedBy(func(r *statistico.TeamResultRequest) bool {
+			a := assert.New(t)
+			a.Equal(uint64(2), r.TeamId)
+			a.Equal("2020-02-29T15:00:00Z", r.GetDateAfter().GetValue())
+			a.Equal(date.Format(time.RFC3339), r.GetDateBefore().GetValue())
+			a.Nil(r.Limit)
+			return true
+		})
+
+		results := []*statistico.Result{
+			previousResult(2, 10, 3),
+			previousResult(11, 2, 7),
+			previousResult(2, 12, 10),
+		}
+
+		client.On("ByTeam", ctx, req).Return(results, nil)
+
+		f := strategy.ScheduleFilter{Team: "AWAY_TEAM", Measure: "MATCHES_PLAYED", Days: 14, Metric: "GTE", Value: 3}
+
+		success, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, success)
+	})
+
+	t.Run("returns error if matches played filter does not contain days", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewScheduleFilterClassifier(client)
+
+		f := strategy.ScheduleFilter{Team: "AWAY_TEAM", Measure: "MATCHES_PLAYED", Metric: "GTE", Value: 3}
+
+		_, err := classifier.MatchesFilter(context.Background(), &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "schedule filter measure MATCHES_PLAYED requires days", err.Error())
+	})
+
+	t.Run("returns error if returned by result client", func(t *testing.T) {
+		t.Helper()
+
+		ctx := context.Background()
+		client := new(m.ResultClient)
+		classifier := strategy.NewScheduleFilterClassifier(client)
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).
+			Return([]*statistico.Result{}, errors.New("oh no"))
+
+		f := strategy.ScheduleFilter{Team: "HOME_TEAM", Measure: "REST_DAYS", Metric: "GTE", Value: 3}
+
+		_, err := classifier.MatchesFilter(ctx, &fixture, &f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "oh no", err.Error())
+	})
+}
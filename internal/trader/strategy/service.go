@@ -3,6 +3,7 @@ package strategy
 import (
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type Writer interface {
@@ -14,6 +15,7 @@ type Reader interface {
 }
 
 type ReaderQuery struct {
+	ID         *uuid.UUID
 	UserID     *uuid.UUID
 	Market     *string
 	Runner     *string
@@ -33,8 +35,30 @@ type FinderQuery struct {
 	Price         float32   `json:"price"`
 	Side          string    `json:"side"`
 	Status        string    `json:"status"`
+	EventDate     time.Time `json:"eventDate"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 type Finder interface {
 	FindMatchingStrategies(ctx context.Context, q *FinderQuery) <-chan *Strategy
 }
+
+type FixtureStatusReader interface {
+	// Get returns the FixtureStatus for a fixture or nil if the fixture does not have a status.
+	Get(fixtureID uint64) (*FixtureStatus, error)
+}
+
+type FixtureStatusWriter interface {
+	Upsert(s *FixtureStatus) error
+}
+
+type BacktestWriter interface {
+	Insert(r *BacktestRun) error
+}
+
+type BacktestReader interface {
+	// Get returns the BacktestRun including trades or nil if the run does not exist.
+	Get(id uuid.UUID) (*BacktestRun, error)
+	// List returns the BacktestRuns for a user without trades, most recent first.
+	List(userID uuid.UUID) ([]*BacktestRun, error)
+}
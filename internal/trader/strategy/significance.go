@@ -0,0 +1,144 @@
+package strategy
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+const (
+	// DefaultMinimumSample is the number of settled trades below which backtest results are flagged as an
+	// insufficient sample when a minimum is not configured.
+	DefaultMinimumSample = 100
+
+	bootstrapConfidenceLevel = 95
+	bootstrapResamples       = 2000
+	bootstrapSeed            = 1
+)
+
+// Significance measures the confidence that backtest results are not down to chance. ExpectedWins is the number
+// of wins implied by the price of each settled trade and PValue is the probability of winning at least Wins trades
+// if every trade won with its implied probability, calculated using an exact binomial test that allows each trade
+// to have a different probability. ROILower and ROIUpper are the bounds of a bootstrap confidence interval for
+// ROI net of commission at ConfidenceLevel percent, resampled with a fixed seed so repeated backtests report the
+// same interval. InsufficientSample is true if fewer than MinimumSample trades were settled.
+type Significance struct {
+	SampleSize         int     `json:"sampleSize"`
+	MinimumSample      int     `json:"minimumSample"`
+	InsufficientSample bool    `json:"insufficientSample"`
+	Wins               int     `json:"wins"`
+	ExpectedWins       float64 `json:"expectedWins"`
+	PValue             float64 `json:"pValue"`
+	ConfidenceLevel    float64 `json:"confidenceLevel"`
+	ROILower           float64 `json:"roiLower"`
+	ROIUpper           float64 `json:"roiUpper"`
+}
+
+// NewSignificance calculates the Significance of a set of trades placed at level stakes, excluding VOID trades
+// and counting HALF_WIN and HALF_LOSE trades as wins and losses.
+func NewSignificance(trades []*Trade, c Commission, userID string, minSample int) (*Significance, error) {
+	var probabilities, profits, risked []float64
+
+	s := Significance{
+		MinimumSample:   minSample,
+		ConfidenceLevel: bootstrapConfidenceLevel,
+		PValue:          1,
+	}
+
+	for _, t := range sortTradesByEventDate(trades) {
+		profit, err := CalculateProfit(t.Side, t.Price, 1, t.Result)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Result == Void {
+			continue
+		}
+
+		if t.Price <= 1 {
+			return nil, fmt.Errorf("trade for event %d has an invalid price %.2f", t.EventID, t.Price)
+		}
+
+		if t.Result == Success || t.Result == HalfWin {
+			s.Wins++
+		}
+
+		p := 1 / float64(t.Price)
+
+		if t.Side == Lay {
+			p = 1 - p
+		}
+
+		s.ExpectedWins += p
+		probabilities = append(probabilities, p)
+		profits = append(profits, float64(CalculateNetProfit(profit, c.Rate(t.Exchange, userID))))
+		risked = append(risked, float64(CalculateLiability(t.Side, t.Price, 1)))
+	}
+
+	s.SampleSize = len(probabilities)
+	s.InsufficientSample = s.SampleSize < minSample
+
+	if s.SampleSize == 0 {
+		return &s, nil
+	}
+
+	s.PValue = binomialPValue(probabilities, s.Wins)
+	s.ROILower, s.ROIUpper = bootstrapROI(profits, risked)
+
+	return &s, nil
+}
+
+// binomialPValue returns the probability of at least wins successes from independent trials with the
+// probabilities provided.
+func binomialPValue(probabilities []float64, wins int) float64 {
+	dist := make([]float64, len(probabilities)+1)
+	dist[0] = 1
+
+	for i, p := range probabilities {
+		for k := i + 1; k > 0; k-- {
+			dist[k] = dist[k]*(1-p) + dist[k-1]*p
+		}
+
+		dist[0] *= 1 - p
+	}
+
+	var pValue float64
+
+	for k := wins; k < len(dist); k++ {
+		pValue += dist[k]
+	}
+
+	if pValue > 1 {
+		return 1
+	}
+
+	return pValue
+}
+
+// bootstrapROI returns the percentile confidence interval for ROI from resampling trades with replacement.
+func bootstrapROI(profits, risked []float64) (float64, float64) {
+	r := rand.New(rand.NewSource(bootstrapSeed))
+	rois := make([]float64, bootstrapResamples)
+
+	for i := range rois {
+		var profit, risk float64
+
+		for range profits {
+			j := r.Intn(len(profits))
+			profit += profits[j]
+			risk += risked[j]
+		}
+
+		rois[i] = profit / risk * 100
+	}
+
+	sort.Float64s(rois)
+
+	tail := (100 - bootstrapConfidenceLevel) / 2.0 / 100
+
+	lower := int(tail * bootstrapResamples)
+	upper := int((1-tail)*bootstrapResamples) - 1
+
+	return rois[lower], rois[upper]
+}
@@ -0,0 +1,104 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSignificance(t *testing.T) {
+	t.Run("calculates binomial p-value against the odds implied win probability", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 2.00, "SUCCESS"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "SUCCESS"),
+			newSummaryTrade(3, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(4, 8, 17420, "LAY", 5.00, "SUCCESS"),
+			newSummaryTrade(5, 8, 17420, "BACK", 2.00, "VOID"),
+		}
+
+		s, err := strategy.NewSignificance(trades, strategy.Commission{}, "", 100)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(4, s.SampleSize)
+		a.Equal(3, s.Wins)
+		a.InDelta(2.3, s.ExpectedWins, 0.0001)
+		a.InDelta(0.425, s.PValue, 0.0001)
+		a.Equal(100, s.MinimumSample)
+		a.True(s.InsufficientSample)
+		a.Equal(float64(95), s.ConfidenceLevel)
+	})
+
+	t.Run("calculates a reproducible bootstrap confidence interval containing the ROI", func(t *testing.T) {
+		t.Helper()
+
+		var trades []*strategy.Trade
+
+		for i := 1; i <= 28; i++ {
+			result := strategy.Result("FAIL")
+
+			if i%2 == 0 {
+				result = "SUCCESS"
+			}
+
+			trades = append(trades, newSummaryTrade(i, 8, 17420, "BACK", 2.20, result))
+		}
+
+		first, err := strategy.NewSignificance(trades, strategy.Commission{}, "", 20)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		second, err := strategy.NewSignificance(trades, strategy.Commission{}, "", 20)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.False(first.InsufficientSample)
+		a.Less(first.ROILower, 10.0)
+		a.Greater(first.ROIUpper, 10.0)
+		a.Less(first.ROILower, 0.0)
+		a.Equal(first.ROILower, second.ROILower)
+		a.Equal(first.ROIUpper, second.ROIUpper)
+	})
+
+	t.Run("returns an empty significance if no trades are settled", func(t *testing.T) {
+		t.Helper()
+
+		s, err := strategy.NewSignificance([]*strategy.Trade{}, strategy.Commission{}, "", 100)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, s.SampleSize)
+		assert.Equal(t, 1.0, s.PValue)
+		assert.True(t, s.InsufficientSample)
+	})
+
+	t.Run("returns an error if a settled trade has an invalid price", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 1.00, "SUCCESS"),
+		}
+
+		_, err := strategy.NewSignificance(trades, strategy.Commission{}, "", 100)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "trade for event 1 has an invalid price 1.00", err.Error())
+	})
+}
@@ -0,0 +1,129 @@
+package strategy
+
+import (
+	"errors"
+	"time"
+)
+
+// Split divides backtest trades into in-sample and out-of-sample periods. CutOff creates a single split where
+// trades before the cut-off are in-sample and the remaining trades are out-of-sample. InSampleDays and
+// OutOfSampleDays create rolling walk-forward windows starting from the first trade, where each window is
+// followed by the next out-of-sample period and windows advance by OutOfSampleDays.
+type Split struct {
+	CutOff          *time.Time `json:"cutOff"`
+	InSampleDays    uint32     `json:"inSampleDays"`
+	OutOfSampleDays uint32     `json:"outOfSampleDays"`
+}
+
+// SplitPerformance contains the Performance of the trades in and out of sample for a split. In-sample trades
+// fall between InSampleFrom and OutOfSampleFrom and out-of-sample trades fall between OutOfSampleFrom and
+// OutOfSampleTo, which is nil for the final period of a cut-off split.
+type SplitPerformance struct {
+	InSampleFrom    time.Time   `json:"inSampleFrom"`
+	OutOfSampleFrom time.Time   `json:"outOfSampleFrom"`
+	OutOfSampleTo   *time.Time  `json:"outOfSampleTo"`
+	InSample        Performance `json:"inSample"`
+	OutOfSample     Performance `json:"outOfSample"`
+}
+
+// Validate returns an error if the Split does not define either a cut-off or walk-forward windows.
+func (s Split) Validate() error {
+	if s.CutOff != nil && (s.InSampleDays > 0 || s.OutOfSampleDays > 0) {
+		return errors.New("split must use either a cut-off or walk-forward windows")
+	}
+
+	if s.CutOff == nil && (s.InSampleDays == 0 || s.OutOfSampleDays == 0) {
+		return errors.New("walk-forward splits require in-sample and out-of-sample days")
+	}
+
+	return nil
+}
+
+// NewSplitPerformance calculates the Performance of trades for each period of the Split, net of the commission
+// charged to the user provided.
+func NewSplitPerformance(trades []*Trade, s Split, c Commission, userID string) ([]*SplitPerformance, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	sorted := sortTradesByEventDate(trades)
+
+	if s.CutOff != nil {
+		var from time.Time
+
+		if len(sorted) > 0 {
+			from = sorted[0].EventDate
+		}
+
+		sp, err := splitPerformance(sorted, from, *s.CutOff, nil, c, userID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*SplitPerformance{sp}, nil
+	}
+
+	splits := []*SplitPerformance{}
+
+	if len(sorted) == 0 {
+		return splits, nil
+	}
+
+	last := sorted[len(sorted)-1].EventDate
+	inSample := time.Duration(s.InSampleDays) * 24 * time.Hour
+	outOfSample := time.Duration(s.OutOfSampleDays) * 24 * time.Hour
+
+	for from := sorted[0].EventDate; !from.Add(inSample).After(last); from = from.Add(outOfSample) {
+		to := from.Add(inSample).Add(outOfSample)
+
+		sp, err := splitPerformance(sorted, from, from.Add(inSample), &to, c, userID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		splits = append(splits, sp)
+	}
+
+	return splits, nil
+}
+
+func splitPerformance(trades []*Trade, from, cutOff time.Time, to *time.Time, c Commission, userID string) (*SplitPerformance, error) {
+	var in, out []*Trade
+
+	for _, t := range trades {
+		if t.EventDate.Before(from) {
+			continue
+		}
+
+		if t.EventDate.Before(cutOff) {
+			in = append(in, t)
+			continue
+		}
+
+		if to == nil || t.EventDate.Before(*to) {
+			out = append(out, t)
+		}
+	}
+
+	inPerf, err := calculatePerformance(in, c, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outPerf, err := calculatePerformance(out, c, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SplitPerformance{
+		InSampleFrom:    from,
+		OutOfSampleFrom: cutOff,
+		OutOfSampleTo:   to,
+		InSample:        *inPerf,
+		OutOfSample:     *outPerf,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewSplitPerformance(t *testing.T) {
+	trades := []*strategy.Trade{
+		newSummaryTrade(1, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		newSummaryTrade(3, 8, 17420, "BACK", 2.00, "SUCCESS"),
+		newSummaryTrade(5, 8, 17420, "BACK", 2.00, "FAIL"),
+		newSummaryTrade(7, 8, 17420, "BACK", 2.00, "FAIL"),
+		newSummaryTrade(9, 8, 17420, "BACK", 3.00, "SUCCESS"),
+	}
+
+	t.Run("splits trades before and after a cut-off", func(t *testing.T) {
+		t.Helper()
+
+		cutOff := time.Date(2021, 5, 5, 0, 0, 0, 0, time.UTC)
+
+		splits, err := strategy.NewSplitPerformance(trades, strategy.Split{CutOff: &cutOff}, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(1, len(splits))
+		a.Equal(time.Date(2021, 5, 1, 15, 0, 0, 0, time.UTC), splits[0].InSampleFrom)
+		a.Equal(cutOff, splits[0].OutOfSampleFrom)
+		a.Nil(splits[0].OutOfSampleTo)
+		a.Equal(2, splits[0].InSample.Trades)
+		a.InDelta(2.0, splits[0].InSample.NetProfit, 0.0001)
+		a.Equal(3, splits[0].OutOfSample.Trades)
+		a.InDelta(0.0, splits[0].OutOfSample.NetProfit, 0.0001)
+	})
+
+	t.Run("splits trades into rolling walk-forward windows", func(t *testing.T) {
+		t.Helper()
+
+		split := strategy.Split{InSampleDays: 4, OutOfSampleDays: 2}
+
+		splits, err := strategy.NewSplitPerformance(trades, split, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, len(splits))
+
+		a.Equal(time.Date(2021, 5, 1, 15, 0, 0, 0, time.UTC), splits[0].InSampleFrom)
+		a.Equal(time.Date(2021, 5, 5, 15, 0, 0, 0, time.UTC), splits[0].OutOfSampleFrom)
+		a.Equal(time.Date(2021, 5, 7, 15, 0, 0, 0, time.UTC), *splits[0].OutOfSampleTo)
+		a.Equal(2, splits[0].InSample.Trades)
+		a.Equal(1, splits[0].OutOfSample.Trades)
+		a.Equal(1, splits[0].OutOfSample.Losses)
+
+		a.Equal(2, splits[1].InSample.Trades)
+		a.Equal(1, splits[1].OutOfSample.Trades)
+
+		a.Equal(time.Date(2021, 5, 5, 15, 0, 0, 0, time.UTC), splits[2].InSampleFrom)
+		a.Equal(2, splits[2].InSample.Trades)
+		a.Equal(1, splits[2].OutOfSample.Trades)
+		a.InDelta(2.0, splits[2].OutOfSample.NetProfit, 0.0001)
+	})
+
+	t.Run("returns an error if the split is not valid", func(t *testing.T) {
+		t.Helper()
+
+		cutOff := time.Date(2021, 5, 5, 0, 0, 0, 0, time.UTC)
+
+		tc := []struct {
+			Split strategy.Split
+			Error string
+		}{
+			{
+				Split: strategy.Split{CutOff: &cutOff, InSampleDays: 4},
+				Error: "split must use either a cut-off or walk-forward windows",
+			},
+			{
+				Split: strategy.Split{InSampleDays: 4},
+				Error: "walk-forward splits require in-sample and out-of-sample days",
+			},
+		}
+
+		for _, c := range tc {
+			_, err := strategy.NewSplitPerformance(trades, c.Split, strategy.Commission{}, "")
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
@@ -2,10 +2,7 @@ package strategy
 
 import (
 	"context"
-	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/statistico/statistico-data-go-grpc-client"
-	"github.com/statistico/statistico-proto/go"
-	"time"
 )
 
 type StatFilterClassifier interface {
@@ -13,7 +10,7 @@ type StatFilterClassifier interface {
 }
 
 type statFilterClassifier struct {
-	resultClient statisticodata.ResultClient
+	fetcher *teamResultFetcher
 }
 
 func (s *statFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture, f *StatFilter) (bool, error) {
@@ -23,23 +20,19 @@ func (s *statFilterClassifier) MatchesFilter(ctx context.Context, fix *Fixture,
 		return false, err
 	}
 
-	req := statistico.TeamResultRequest{
-		TeamId:     teamID,
-		Limit:      &wrappers.UInt64Value{Value: uint64(f.Games)},
-		DateBefore: &wrappers.StringValue{Value: fix.Date.Format(time.RFC3339)},
-		SeasonIds:  []uint64{fix.SeasonID},
-		Venue:      &wrappers.StringValue{Value: f.Venue},
-	}
-
-	results, err := s.resultClient.ByTeam(ctx, &req)
+	results, err := s.fetcher.fetch(ctx, fix, teamID, f.Games, f.Venue, f.Lookback)
 
 	if err != nil {
 		return false, err
 	}
 
+	if !meetsMinimumGames(results, f.Games, f.MinGames) {
+		return false, nil
+	}
+
 	return statMeetsCriteria(results, teamID, f)
 }
 
-func NewStatFilterClassifier(c statisticodata.ResultClient) StatFilterClassifier {
-	return &statFilterClassifier{resultClient: c}
+func NewStatFilterClassifier(r statisticodata.ResultClient, s statisticodata.SeasonClient) StatFilterClassifier {
+	return &statFilterClassifier{fetcher: &teamResultFetcher{resultClient: r, seasonClient: s}}
 }
@@ -3,6 +3,8 @@ package strategy
 import (
 	"fmt"
 	"github.com/statistico/statistico-proto/go"
+	"math"
+	"sort"
 )
 
 func statMeetsCriteria(rs []*statistico.Result, teamID uint64, f *StatFilter) (bool, error) {
@@ -12,29 +14,34 @@ func statMeetsCriteria(rs []*statistico.Result, teamID uint64, f *StatFilter) (b
 		return false, err
 	}
 
-	switch f.Measure {
-	case Average:
-		return meetsAverageCriteria(values, f.Metric, f.Value)
-	case Continuous:
+	if f.Measure == Continuous {
 		return meetsContinuousCriteria(values, f.Metric, f.Value)
-	case Total:
-		return meetsTotalCriteria(values, f.Metric, f.Value)
-	default:
-		return false, fmt.Errorf("metric %s is not supported", f.Metric)
 	}
-}
 
-func parseStatValues(rs []*statistico.Result, teamID uint64, f *StatFilter) ([]uint32, error) {
-	var values []uint32
+	if len(values) == 0 && f.Measure != Total {
+		return false, nil
+	}
 
-	for _, res := range rs {
-		stats, err := parseTeamStats(res, teamID, f.Action)
+	calc, err := calculateMeasure(values, f)
 
-		if err != nil {
-			return values, err
-		}
+	if err != nil {
+		return false, err
+	}
+
+	// The metric of a PERCENTAGE filter is applied to each value against the threshold so the percentage of
+	// values meeting it only needs to be greater than or equal to value.
+	if f.Measure == Percentage {
+		return meetsMetric(calc, Gte, f.Value)
+	}
+
+	return meetsMetric(calc, f.Metric, f.Value)
+}
+
+func parseStatValues(rs []*statistico.Result, teamID uint64, f *StatFilter) ([]float32, error) {
+	var values []float32
 
-		val, err := parseStatValue(stats, f.Stat)
+	for _, res := range rs {
+		val, err := parseResultStatValue(res, teamID, f)
 
 		if err != nil {
 			return values, err
@@ -46,6 +53,26 @@ func parseStatValues(rs []*statistico.Result, teamID uint64, f *StatFilter) ([]u
 	return values, nil
 }
 
+func parseResultStatValue(res *statistico.Result, teamID uint64, f *StatFilter) (float32, error) {
+	if isDerivedStat(f.Stat) {
+		return parseDerivedStatValue(res, teamID, f.Action, f.Stat)
+	}
+
+	stats, err := parseTeamStats(res, teamID, f.Action)
+
+	if err != nil {
+		return 0, err
+	}
+
+	val, err := parseStatValue(stats, f.Stat)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return float32(val), nil
+}
+
 func parseTeamStats(res *statistico.Result, teamID uint64, action string) (*statistico.TeamStats, error) {
 	if action == ActionFor {
 		if res.HomeTeam.Id == teamID {
@@ -84,24 +111,72 @@ func parseTeamStats(res *statistico.Result, teamID uint64, action string) (*stat
 
 func parseStatValue(s *statistico.TeamStats, stat string) (uint32, error) {
 	switch stat {
+	case AttacksDangerous:
+		return s.AttacksDangerous.GetValue(), nil
+	case AttacksTotal:
+		return s.AttacksTotal.GetValue(), nil
+	case Corners:
+		return s.Corners.GetValue(), nil
+	case Fouls:
+		return s.Fouls.GetValue(), nil
+	case FreeKicks:
+		return s.FreeKicks.GetValue(), nil
+	case GoalAttempts:
+		return s.GoalAttempts.GetValue(), nil
+	case GoalKicks:
+		return s.GoalKicks.GetValue(), nil
 	case Goals:
 		return s.Goals.GetValue(), nil
+	case Offsides:
+		return s.Offsides.GetValue(), nil
+	case PassesAccuracy:
+		return s.PassesAccuracy.GetValue(), nil
+	case PassesPercentage:
+		return s.PassesPercentage.GetValue(), nil
+	case PassesTotal:
+		return s.PassesTotal.GetValue(), nil
+	case Possession:
+		return s.Possession.GetValue(), nil
+	case RedCards:
+		return s.RedCards.GetValue(), nil
+	case Saves:
+		return s.Saves.GetValue(), nil
+	case ShotsBlocked:
+		return s.ShotsBlocked.GetValue(), nil
+	case ShotsInsideBox:
+		return s.ShotsInsideBox.GetValue(), nil
+	case ShotsOffGoal:
+		return s.ShotsOffGoal.GetValue(), nil
 	case ShotsOnGoal:
 		return s.ShotsOnGoal.GetValue(), nil
+	case ShotsOutsideBox:
+		return s.ShotsOutsideBox.GetValue(), nil
+	case ShotsTotal:
+		return s.ShotsTotal.GetValue(), nil
+	case Substitutions:
+		return s.Substitutions.GetValue(), nil
+	case ThrowIns:
+		return s.ThrowIns.GetValue(), nil
+	case YellowCards:
+		return s.YellowCards.GetValue(), nil
 	default:
 		return 0, fmt.Errorf("stat %s is not supported", stat)
 	}
 }
 
-func meetsAverageCriteria(values []uint32, metric string, value float32) (bool, error) {
-	var val uint32
-
+func meetsContinuousCriteria(values []float32, metric string, value float32) (bool, error) {
 	for _, v := range values {
-		val += v
+		success, err := meetsMetric(v, metric, value)
+
+		if err != nil || !success {
+			return false, err
+		}
 	}
 
-	calc := float32(val) / float32(len(values))
+	return true, nil
+}
 
+func meetsMetric(calc float32, metric string, value float32) (bool, error) {
 	if metric == Gte {
 		return (float32(int(calc*100)) / 100) >= value, nil
 	}
@@ -113,46 +188,104 @@ func meetsAverageCriteria(values []uint32, metric string, value float32) (bool,
 	return false, fmt.Errorf("metric %s is not supported", metric)
 }
 
-func meetsContinuousCriteria(values []uint32, metric string, value float32) (bool, error) {
+// calculateMeasure reduces values to a single figure using the measure provided. CONTINUOUS is not supported as
+// it is a condition applied to each value rather than a calculation. Only TOTAL, which is zero, can be calculated
+// without values.
+func calculateMeasure(values []float32, f *StatFilter) (float32, error) {
+	if len(values) == 0 && f.Measure != Total {
+		return 0, fmt.Errorf("unable to calculate measure %s without values", f.Measure)
+	}
+
+	switch f.Measure {
+	case Average:
+		return calculateTotal(values) / float32(len(values)), nil
+	case Median:
+		return calculateMedian(values), nil
+	case Percentage:
+		return calculatePercentage(values, f.Metric, f.Threshold)
+	case StandardDeviation:
+		return calculateStandardDeviation(values), nil
+	case Total:
+		return calculateTotal(values), nil
+	case WeightedAverage:
+		return calculateWeightedAverage(values, f.Decay)
+	default:
+		return 0, fmt.Errorf("measure %s is not supported", f.Measure)
+	}
+}
+
+func calculateTotal(values []float32) float32 {
+	var total float32
+
 	for _, v := range values {
-		if metric == Gte {
-			if float32(v) < value {
-				return false, nil
-			}
+		total += v
+	}
 
-			continue
-		}
+	return total
+}
 
-		if metric == Lte {
-			if float32(v) > value {
-				return false, nil
-			}
+func calculateMedian(values []float32) float32 {
+	sorted := make([]float32, len(values))
+	copy(sorted, values)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
 
-			continue
+	mid := len(sorted) / 2
+
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
+
+func calculatePercentage(values []float32, metric string, threshold float32) (float32, error) {
+	var count int
+
+	for _, v := range values {
+		success, err := meetsMetric(v, metric, threshold)
+
+		if err != nil {
+			return 0, err
 		}
 
-		return false, fmt.Errorf("metric %s is not supported", metric)
+		if success {
+			count++
+		}
 	}
 
-	return true, nil
+	return float32(count) / float32(len(values)) * 100, nil
 }
 
-func meetsTotalCriteria(values []uint32, metric string, value float32) (bool, error) {
-	var val uint32
+func calculateStandardDeviation(values []float32) float32 {
+	mean := float64(calculateTotal(values)) / float64(len(values))
+
+	var variance float64
 
 	for _, v := range values {
-		val += v
+		variance += math.Pow(float64(v)-mean, 2)
 	}
 
-	calc := float32(val)
+	return float32(math.Sqrt(variance / float64(len(values))))
+}
 
-	if metric == Gte {
-		return (float32(int(calc*100)) / 100) >= value, nil
+// calculateWeightedAverage calculates an exponentially weighted average where values are ordered most recent
+// first. Each value is weighted (1 - decay) times the value after it, so a decay of zero is a simple average and
+// higher decays favour recent form.
+func calculateWeightedAverage(values []float32, decay float32) (float32, error) {
+	if decay < 0 || decay >= 1 {
+		return 0, fmt.Errorf("decay %.2f must be greater than or equal to 0 and less than 1", decay)
 	}
 
-	if metric == Lte {
-		return (float32(int(calc*100)) / 100) <= value, nil
+	var total, weights float64
+
+	for i, v := range values {
+		w := math.Pow(1-float64(decay), float64(i))
+		total += float64(v) * w
+		weights += w
 	}
 
-	return false, fmt.Errorf("metric %s is not supported", metric)
+	return float32(total / weights), nil
 }
@@ -26,6 +26,56 @@ func Test_parseStatValue(t *testing.T) {
 				Stat:  "SHOTS_ON_GOAL",
 				Value: 12,
 			},
+			{
+				Stats: &statistico.TeamStats{ShotsTotal: &wrappers.UInt32Value{Value: 18}},
+				Stat:  "SHOTS_TOTAL",
+				Value: 18,
+			},
+			{
+				Stats: &statistico.TeamStats{Corners: &wrappers.UInt32Value{Value: 7}},
+				Stat:  "CORNERS",
+				Value: 7,
+			},
+			{
+				Stats: &statistico.TeamStats{Possession: &wrappers.UInt32Value{Value: 63}},
+				Stat:  "POSSESSION",
+				Value: 63,
+			},
+			{
+				Stats: &statistico.TeamStats{Fouls: &wrappers.UInt32Value{Value: 11}},
+				Stat:  "FOULS",
+				Value: 11,
+			},
+			{
+				Stats: &statistico.TeamStats{YellowCards: &wrappers.UInt32Value{Value: 3}},
+				Stat:  "YELLOW_CARDS",
+				Value: 3,
+			},
+			{
+				Stats: &statistico.TeamStats{RedCards: &wrappers.UInt32Value{Value: 1}},
+				Stat:  "RED_CARDS",
+				Value: 1,
+			},
+			{
+				Stats: &statistico.TeamStats{Offsides: &wrappers.UInt32Value{Value: 2}},
+				Stat:  "OFFSIDES",
+				Value: 2,
+			},
+			{
+				Stats: &statistico.TeamStats{Saves: &wrappers.UInt32Value{Value: 5}},
+				Stat:  "SAVES",
+				Value: 5,
+			},
+			{
+				Stats: &statistico.TeamStats{AttacksDangerous: &wrappers.UInt32Value{Value: 54}},
+				Stat:  "ATTACKS_DANGEROUS",
+				Value: 54,
+			},
+			{
+				Stats: &statistico.TeamStats{},
+				Stat:  "CORNERS",
+				Value: 0,
+			},
 		}
 
 		for _, c := range tc {
@@ -125,14 +175,14 @@ func Test_parseTeamStats(t *testing.T) {
 }
 
 func Test_parseStatValues(t *testing.T) {
-	t.Run("returns a slice of uint32 values", func(t *testing.T) {
+	t.Run("returns a slice of float32 values", func(t *testing.T) {
 		t.Helper()
 
 		tc := []struct {
 			Results  []*statistico.Result
 			TeamID   uint64
 			Filter   *StatFilter
-			Expected []uint32
+			Expected []float32
 		}{
 			{
 				Results: []*statistico.Result{
@@ -154,7 +204,7 @@ func Test_parseStatValues(t *testing.T) {
 					Stat:   "GOALS",
 					Action: "FOR",
 				},
-				Expected: []uint32{4, 0, 1},
+				Expected: []float32{4, 0, 1},
 			},
 			{
 				Results: []*statistico.Result{
@@ -176,7 +226,7 @@ func Test_parseStatValues(t *testing.T) {
 					Stat:   "GOALS",
 					Action: "AGAINST",
 				},
-				Expected: []uint32{4, 0, 1},
+				Expected: []float32{4, 0, 1},
 			},
 		}
 
@@ -564,3 +614,111 @@ func Test_statMeetsCriteria(t *testing.T) {
 		}
 	})
 }
+
+func Test_statMeetsCriteria_measures(t *testing.T) {
+	// Total goals ordered most recent first: 4, 0, 1, 3, 2
+	results := []*statistico.Result{
+		newScoreResult(55, 1, 3, 1),
+		newScoreResult(2, 55, 0, 0),
+		newScoreResult(55, 3, 1, 0),
+		newScoreResult(4, 55, 2, 1),
+		newScoreResult(55, 5, 1, 1),
+	}
+
+	t.Run("calculates median, standard deviation, percentage and weighted average measures", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Filter   *StatFilter
+			Expected bool
+		}{
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "MEDIAN", Metric: "GTE", Value: 2}, true},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "MEDIAN", Metric: "GTE", Value: 2.5}, false},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "STDDEV", Metric: "LTE", Value: 1.42}, true},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "STDDEV", Metric: "LTE", Value: 1.4}, false},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "PERCENTAGE", Metric: "GTE", Threshold: 2, Value: 60}, true},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "PERCENTAGE", Metric: "GTE", Threshold: 2, Value: 70}, false},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "PERCENTAGE", Metric: "LTE", Threshold: 1, Value: 40}, true},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "WEIGHTED_AVERAGE", Metric: "GTE", Decay: 0.5, Value: 2.45}, true},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "WEIGHTED_AVERAGE", Metric: "GTE", Decay: 0, Value: 2.1}, false},
+			{&StatFilter{Stat: "GOAL_DIFFERENCE", Action: "FOR", Measure: "MEDIAN", Metric: "LTE", Value: 0}, true},
+		}
+
+		for index, c := range tc {
+			yes, err := statMeetsCriteria(results, 55, c.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), index)
+			}
+
+			assert.Equal(t, c.Expected, yes, "index %d", index)
+		}
+	})
+
+	t.Run("calculates median of an even number of values", func(t *testing.T) {
+		t.Helper()
+
+		f := &StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "MEDIAN", Metric: "LTE", Value: 1.5}
+
+		yes, err := statMeetsCriteria(results[1:], 55, f)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, yes)
+	})
+
+	t.Run("returns false if no results are provided", func(t *testing.T) {
+		t.Helper()
+
+		for _, measure := range []string{"MEDIAN", "STDDEV", "PERCENTAGE", "WEIGHTED_AVERAGE"} {
+			f := &StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: measure, Metric: "LTE", Value: 10}
+
+			yes, err := statMeetsCriteria([]*statistico.Result{}, 55, f)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.False(t, yes, measure)
+		}
+	})
+
+	t.Run("returns an error if measure or metric is not supported", func(t *testing.T) {
+		t.Helper()
+
+		assertions := []struct {
+			Filter *StatFilter
+			Error  string
+		}{
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "INVALID", Metric: "GTE", Value: 2}, "measure INVALID is not supported"},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "AVERAGE", Metric: "INVALID", Value: 2}, "metric INVALID is not supported"},
+			{&StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "CONTINUOUS", Metric: "INVALID", Value: 2}, "metric INVALID is not supported"},
+		}
+
+		for index, a := range assertions {
+			_, err := statMeetsCriteria(results, 55, a.Filter)
+
+			if err == nil {
+				t.Fatalf("Expected error, got nil at index %d", index)
+			}
+
+			assert.Equal(t, a.Error, err.Error(), "index %d", index)
+		}
+	})
+
+	t.Run("returns error if weighted average decay is invalid", func(t *testing.T) {
+		t.Helper()
+
+		f := &StatFilter{Stat: "TOTAL_GOALS", Action: "FOR", Measure: "WEIGHTED_AVERAGE", Metric: "GTE", Decay: 1, Value: 2}
+
+		_, err := statMeetsCriteria(results, 55, f)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "decay 1.00 must be greater than or equal to 0 and less than 1", err.Error())
+	})
+}
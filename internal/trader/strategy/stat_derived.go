@@ -0,0 +1,91 @@
+package strategy
+
+import (
+	"fmt"
+	"github.com/statistico/statistico-proto/go"
+)
+
+func isDerivedStat(stat string) bool {
+	switch stat {
+	case BothTeamsScored, CleanSheet, FailedToScore, GoalDifference, Points, TotalGoals:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseDerivedStatValue calculates a stat from the final score of a Result rather than reading it from the
+// TeamStats struct. The action provided sets the perspective, FOR returns the value for the team and AGAINST
+// returns the value for its opponent.
+func parseDerivedStatValue(res *statistico.Result, teamID uint64, action, stat string) (float32, error) {
+	home, away, err := parseGoalScored(res)
+
+	if err != nil {
+		return 0, err
+	}
+
+	scored, conceded, err := parseTeamScore(res, teamID, action, home, away)
+
+	if err != nil {
+		return 0, err
+	}
+
+	switch stat {
+	case BothTeamsScored:
+		return boolToStatValue(home > 0 && away > 0), nil
+	case CleanSheet:
+		return boolToStatValue(conceded == 0), nil
+	case FailedToScore:
+		return boolToStatValue(scored == 0), nil
+	case GoalDifference:
+		return float32(scored) - float32(conceded), nil
+	case Points:
+		return calculatePoints(scored, conceded), nil
+	case TotalGoals:
+		return float32(home + away), nil
+	default:
+		return 0, fmt.Errorf("stat %s is not supported", stat)
+	}
+}
+
+func parseTeamScore(res *statistico.Result, teamID uint64, action string, home, away uint32) (uint32, uint32, error) {
+	isHome := res.GetHomeTeam().GetId() == teamID
+
+	if action == ActionFor {
+		if isHome {
+			return home, away, nil
+		}
+
+		return away, home, nil
+	}
+
+	if action == ActionAgainst {
+		if isHome {
+			return away, home, nil
+		}
+
+		return home, away, nil
+	}
+
+	return 0, 0, fmt.Errorf("action %s is not supported", action)
+}
+
+func calculatePoints(scored, conceded uint32) float32 {
+	if scored > conceded {
+		return 3
+	}
+
+	if scored == conceded {
+		return 1
+	}
+
+	return 0
+}
+
+func boolToStatValue(b bool) float32 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,234 @@
+package strategy
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_parseDerivedStatValue(t *testing.T) {
+	t.Run("calculates stat value from the final score of a result", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Result *statistico.Result
+			TeamID uint64
+			Action string
+			Stat   string
+			Value  float32
+		}{
+			{
+				Result: newScoreResult(1, 2, 3, 1),
+				TeamID: 1,
+				Action: "FOR",
+				Stat:   "GOAL_DIFFERENCE",
+				Value:  2,
+			},
+			{
+				Result: newScoreResult(1, 2, 3, 1),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "GOAL_DIFFERENCE",
+				Value:  -2,
+			},
+			{
+				Result: newScoreResult(1, 2, 3, 1),
+				TeamID: 1,
+				Action: "AGAINST",
+				Stat:   "GOAL_DIFFERENCE",
+				Value:  -2,
+			},
+			{
+				Result: newScoreResult(1, 2, 3, 1),
+				TeamID: 1,
+				Action: "FOR",
+				Stat:   "POINTS",
+				Value:  3,
+			},
+			{
+				Result: newScoreResult(1, 2, 3, 1),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "POINTS",
+				Value:  0,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 2),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "POINTS",
+				Value:  1,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 0),
+				TeamID: 1,
+				Action: "FOR",
+				Stat:   "CLEAN_SHEET",
+				Value:  1,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 0),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "CLEAN_SHEET",
+				Value:  0,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 0),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "FAILED_TO_SCORE",
+				Value:  1,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 0),
+				TeamID: 1,
+				Action: "FOR",
+				Stat:   "FAILED_TO_SCORE",
+				Value:  0,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 1),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "BOTH_TEAMS_SCORED",
+				Value:  1,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 0),
+				TeamID: 2,
+				Action: "FOR",
+				Stat:   "BOTH_TEAMS_SCORED",
+				Value:  0,
+			},
+			{
+				Result: newScoreResult(1, 2, 2, 3),
+				TeamID: 1,
+				Action: "AGAINST",
+				Stat:   "TOTAL_GOALS",
+				Value:  5,
+			},
+		}
+
+		for _, c := range tc {
+			val, err := parseDerivedStatValue(c.Result, c.TeamID, c.Action, c.Stat)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, c.Value, val)
+		}
+	})
+
+	t.Run("returns an error if result does not contain a score", func(t *testing.T) {
+		t.Helper()
+
+		res := &statistico.Result{
+			Id:       19281,
+			HomeTeam: &statistico.Team{Id: 1},
+			AwayTeam: &statistico.Team{Id: 2},
+		}
+
+		_, err := parseDerivedStatValue(res, 1, "FOR", "POINTS")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "unable to parse match stats for fixture 19281", err.Error())
+	})
+
+	t.Run("returns an error if action provided is not supported", func(t *testing.T) {
+		t.Helper()
+
+		_, err := parseDerivedStatValue(newScoreResult(1, 2, 2, 0), 1, "INVALID", "POINTS")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "action INVALID is not supported", err.Error())
+	})
+}
+
+func Test_statMeetsCriteria_derivedStats(t *testing.T) {
+	t.Run("derived stats are evaluated using the provided measure", func(t *testing.T) {
+		t.Helper()
+
+		results := []*statistico.Result{
+			newScoreResult(1, 5, 2, 0),
+			newScoreResult(6, 1, 1, 1),
+			newScoreResult(1, 7, 3, 2),
+		}
+
+		tc := []struct {
+			Filter   *StatFilter
+			Expected bool
+		}{
+			{
+				Filter: &StatFilter{
+					Stat:    "POINTS",
+					Action:  "FOR",
+					Measure: "AVERAGE",
+					Metric:  "GTE",
+					Value:   2,
+				},
+				Expected: true,
+			},
+			{
+				Filter: &StatFilter{
+					Stat:    "POINTS",
+					Action:  "FOR",
+					Measure: "AVERAGE",
+					Metric:  "GTE",
+					Value:   2.5,
+				},
+				Expected: false,
+			},
+			{
+				Filter: &StatFilter{
+					Stat:    "GOAL_DIFFERENCE",
+					Action:  "AGAINST",
+					Measure: "TOTAL",
+					Metric:  "LTE",
+					Value:   -3,
+				},
+				Expected: true,
+			},
+			{
+				Filter: &StatFilter{
+					Stat:    "CLEAN_SHEET",
+					Action:  "FOR",
+					Measure: "TOTAL",
+					Metric:  "GTE",
+					Value:   2,
+				},
+				Expected: false,
+			},
+		}
+
+		for _, c := range tc {
+			success, err := statMeetsCriteria(results, 1, c.Filter)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, c.Expected, success)
+		}
+	})
+}
+
+func newScoreResult(homeID, awayID uint64, homeGoals, awayGoals uint32) *statistico.Result {
+	return &statistico.Result{
+		Id:       1,
+		HomeTeam: &statistico.Team{Id: homeID},
+		AwayTeam: &statistico.Team{Id: awayID},
+		Stats: &statistico.MatchStats{
+			HomeScore: &wrappers.UInt32Value{Value: homeGoals},
+			AwayScore: &wrappers.UInt32Value{Value: awayGoals},
+		},
+	}
+}
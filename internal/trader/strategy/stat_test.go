@@ -73,7 +73,7 @@ func TestStatClassifier_MatchesFilter(t *testing.T) {
 
 		for index, res := range assertions {
 			client := new(m.ResultClient)
-			classifier := strategy.NewStatFilterClassifier(client)
+			classifier := strategy.NewStatFilterClassifier(client, new(m.SeasonClient))
 
 			ctx := context.Background()
 
@@ -159,7 +159,7 @@ func TestStatClassifier_MatchesFilter(t *testing.T) {
 
 		for index, res := range assertions {
 			client := new(m.ResultClient)
-			classifier := strategy.NewStatFilterClassifier(client)
+			classifier := strategy.NewStatFilterClassifier(client, new(m.SeasonClient))
 
 			ctx := context.Background()
 
@@ -189,7 +189,7 @@ func TestStatClassifier_MatchesFilter(t *testing.T) {
 		t.Helper()
 
 		client := new(m.ResultClient)
-		classifier := strategy.NewStatFilterClassifier(client)
+		classifier := strategy.NewStatFilterClassifier(client, new(m.SeasonClient))
 
 		fixture := &strategy.Fixture{
 			ID:         55,
@@ -239,4 +239,49 @@ func TestStatClassifier_MatchesFilter(t *testing.T) {
 		assert.False(t, success)
 		assert.Equal(t, "invalid argument", err.Error())
 	})
+
+	t.Run("returns false if fewer results than minimum games are returned", func(t *testing.T) {
+		t.Helper()
+
+		client := new(m.ResultClient)
+		classifier := strategy.NewStatFilterClassifier(client, new(m.SeasonClient))
+
+		fixture := &strategy.Fixture{
+			ID:         55,
+			HomeTeamID: 1,
+			AwayTeamID: 2,
+			Date:       time.Unix(1584014400, 0),
+			SeasonID:   8,
+		}
+
+		ctx := context.Background()
+
+		filter := &strategy.StatFilter{
+			Stat:     "GOALS",
+			Team:     "HOME_TEAM",
+			Action:   "FOR",
+			Games:    5,
+			Measure:  "AVERAGE",
+			Metric:   "GTE",
+			Value:    1,
+			Venue:    "HOME_AWAY",
+			MinGames: 4,
+		}
+
+		results := []*statistico.Result{
+			newProtoResult(1, 5, 4, 4),
+			newProtoResult(10, 1, 5, 5),
+			newProtoResult(1, 11, 2, 2),
+		}
+
+		client.On("ByTeam", ctx, mock.AnythingOfType("*statistico.TeamResultRequest")).Return(results, nil)
+
+		success, err := classifier.MatchesFilter(ctx, fixture, filter)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, success)
+	})
 }
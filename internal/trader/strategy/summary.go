@@ -0,0 +1,163 @@
+package strategy
+
+import "sort"
+
+// Performance contains the results of a set of trades placed at level stakes of one unit. NetProfit is Profit
+// minus the Commission charged on winning trades. Yield is net profit as a percentage of stakes and ROI is net
+// profit as a percentage of the amount risked, which is the liability for Lay trades. MaxDrawdown is also
+// calculated from net profit and ClosingLine compares entry prices with closing prices. HALF_WIN and HALF_LOSE
+// trades count as wins and losses and VOID trades are excluded from every measure other than Voids.
+type Performance struct {
+	Trades               int              `json:"trades"`
+	Wins                 int              `json:"wins"`
+	Losses               int              `json:"losses"`
+	Voids                int              `json:"voids"`
+	StrikeRate           float64          `json:"strikeRate"`
+	Staked               float64          `json:"staked"`
+	Risked               float64          `json:"risked"`
+	Profit               float64          `json:"profit"`
+	Commission           float64          `json:"commission"`
+	NetProfit            float64          `json:"netProfit"`
+	ROI                  float64          `json:"roi"`
+	Yield                float64          `json:"yield"`
+	MaxDrawdown          float64          `json:"maxDrawdown"`
+	LongestWinningStreak int              `json:"longestWinningStreak"`
+	LongestLosingStreak  int              `json:"longestLosingStreak"`
+	ClosingLine          ClosingLineValue `json:"closingLine"`
+}
+
+// Summary contains the Performance of a backtest along with the Performance of each competition and season.
+// Significance is not calculated by NewSummary and is nil unless set by the caller using NewSignificance.
+type Summary struct {
+	Performance
+	Competitions map[uint64]*Performance `json:"competitions"`
+	Seasons      map[uint64]*Performance `json:"seasons"`
+	Significance *Significance           `json:"significance,omitempty"`
+}
+
+// NewSummary calculates the Summary for a set of trades, charging commission at the rate each trade's exchange
+// charges the user provided. Trades are ordered by event date so drawdown and streaks are calculated in the order
+// the trades would have been placed.
+func NewSummary(trades []*Trade, c Commission, userID string) (*Summary, error) {
+	sorted := sortTradesByEventDate(trades)
+
+	p, err := calculatePerformance(sorted, c, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	competitions := map[uint64][]*Trade{}
+	seasons := map[uint64][]*Trade{}
+
+	for _, t := range sorted {
+		competitions[t.CompetitionID] = append(competitions[t.CompetitionID], t)
+		seasons[t.SeasonID] = append(seasons[t.SeasonID], t)
+	}
+
+	s := Summary{
+		Performance:  *p,
+		Competitions: map[uint64]*Performance{},
+		Seasons:      map[uint64]*Performance{},
+	}
+
+	for id, tr := range competitions {
+		if s.Competitions[id], err = calculatePerformance(tr, c, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	for id, tr := range seasons {
+		if s.Seasons[id], err = calculatePerformance(tr, c, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &s, nil
+}
+
+func calculatePerformance(trades []*Trade, c Commission, userID string) (*Performance, error) {
+	p := Performance{}
+
+	var peak float64
+	var winning, losing int
+
+	for _, t := range trades {
+		profit, err := CalculateProfit(t.Side, t.Price, 1, t.Result)
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch t.Result {
+		case Success, HalfWin:
+			p.Wins++
+			winning++
+			losing = 0
+		case Fail, HalfLose:
+			p.Losses++
+			losing++
+			winning = 0
+		default:
+			p.Voids++
+			continue
+		}
+
+		p.Trades++
+
+		net := CalculateNetProfit(profit, c.Rate(t.Exchange, userID))
+
+		p.ClosingLine.Add(t.Side, t.Price, t.ClosingPrice)
+
+		p.Staked++
+		p.Risked += float64(CalculateLiability(t.Side, t.Price, 1))
+		p.Profit += float64(profit)
+		p.Commission += float64(profit - net)
+		p.NetProfit += float64(net)
+
+		if p.NetProfit > peak {
+			peak = p.NetProfit
+		}
+
+		if peak-p.NetProfit > p.MaxDrawdown {
+			p.MaxDrawdown = peak - p.NetProfit
+		}
+
+		if winning > p.LongestWinningStreak {
+			p.LongestWinningStreak = winning
+		}
+
+		if losing > p.LongestLosingStreak {
+			p.LongestLosingStreak = losing
+		}
+	}
+
+	if settled := p.Wins + p.Losses; settled > 0 {
+		p.StrikeRate = float64(p.Wins) / float64(settled) * 100
+	}
+
+	if p.Staked > 0 {
+		p.Yield = p.NetProfit / p.Staked * 100
+	}
+
+	if p.Risked > 0 {
+		p.ROI = p.NetProfit / p.Risked * 100
+	}
+
+	return &p, nil
+}
+
+func sortTradesByEventDate(trades []*Trade) []*Trade {
+	sorted := make([]*Trade, len(trades))
+	copy(sorted, trades)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].EventDate.Equal(sorted[j].EventDate) {
+			return sorted[i].EventID < sorted[j].EventID
+		}
+
+		return sorted[i].EventDate.Before(sorted[j].EventDate)
+	})
+
+	return sorted
+}
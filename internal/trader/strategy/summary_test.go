@@ -0,0 +1,186 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewSummary(t *testing.T) {
+	t.Run("calculates performance for trades ordered by event date", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(5, 8, 17420, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(1, 8, 17420, "BACK", 3.00, "SUCCESS"),
+			newSummaryTrade(2, 8, 17420, "BACK", 2.00, "SUCCESS"),
+			newSummaryTrade(3, 9, 17421, "BACK", 2.00, "FAIL"),
+			newSummaryTrade(4, 9, 17421, "BACK", 2.00, "VOID"),
+			newSummaryTrade(6, 9, 17421, "BACK", 2.00, "FAIL"),
+		}
+
+		s, err := strategy.NewSummary(trades, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(5, s.Trades)
+		a.Equal(2, s.Wins)
+		a.Equal(3, s.Losses)
+		a.Equal(1, s.Voids)
+		a.InDelta(40.0, s.StrikeRate, 0.0001)
+		a.InDelta(5.0, s.Staked, 0.0001)
+		a.InDelta(0.0, s.Profit, 0.0001)
+		a.InDelta(0.0, s.ROI, 0.0001)
+		a.InDelta(0.0, s.Yield, 0.0001)
+		a.InDelta(3.0, s.MaxDrawdown, 0.0001)
+		a.Equal(2, s.LongestWinningStreak)
+		a.Equal(3, s.LongestLosingStreak)
+
+		a.Equal(3, s.Competitions[8].Trades)
+		a.InDelta(2.0, s.Competitions[8].Profit, 0.0001)
+		a.InDelta(1.0, s.Competitions[8].MaxDrawdown, 0.0001)
+		a.Equal(2, s.Seasons[17421].Trades)
+		a.InDelta(-2.0, s.Seasons[17421].Profit, 0.0001)
+		a.Equal(2, s.Seasons[17421].LongestLosingStreak)
+	})
+
+	t.Run("calculates ROI using the liability of lay trades", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "LAY", 3.00, "SUCCESS"),
+			newSummaryTrade(2, 8, 17420, "LAY", 3.00, "SUCCESS"),
+			newSummaryTrade(3, 8, 17420, "LAY", 3.00, "HALF_LOSE"),
+		}
+
+		s, err := strategy.NewSummary(trades, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(1.0, s.Profit, 0.0001)
+		a.InDelta(3.0, s.Staked, 0.0001)
+		a.InDelta(6.0, s.Risked, 0.0001)
+		a.InDelta(33.3333, s.Yield, 0.0001)
+		a.InDelta(16.6667, s.ROI, 0.0001)
+		a.InDelta(1.0, s.MaxDrawdown, 0.0001)
+	})
+
+	t.Run("reports profit net of the commission charged to the user", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "LAY", 3.00, "SUCCESS"),
+			newSummaryTrade(2, 8, 17420, "LAY", 3.00, "FAIL"),
+			newSummaryTrade(3, 8, 17420, "BACK", 3.00, "SUCCESS"),
+		}
+
+		c := strategy.Commission{
+			Rates:     map[string]float32{"betfair": 5, "smarkets": 2},
+			Discounts: map[string]float32{"user-1": 20},
+		}
+
+		s, err := strategy.NewSummary(trades, c, "user-1")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.InDelta(1.0, s.Profit, 0.0001)
+		a.InDelta(0.12, s.Commission, 0.0001)
+		a.InDelta(0.88, s.NetProfit, 0.0001)
+		a.InDelta(29.3333, s.Yield, 0.0001)
+		a.InDelta(17.6, s.ROI, 0.0001)
+		a.InDelta(2.0, s.MaxDrawdown, 0.0001)
+		a.InDelta(0.88, s.Competitions[8].NetProfit, 0.0001)
+	})
+
+	t.Run("calculates closing line value of settled trades with a closing price", func(t *testing.T) {
+		t.Helper()
+
+		closing := func(t *strategy.Trade, price float32) *strategy.Trade {
+			t.ClosingPrice = &price
+			return t
+		}
+
+		trades := []*strategy.Trade{
+			closing(newSummaryTrade(1, 8, 17420, "BACK", 2.10, "SUCCESS"), 2.00),
+			closing(newSummaryTrade(2, 8, 17420, "BACK", 1.90, "FAIL"), 2.00),
+			closing(newSummaryTrade(3, 9, 17420, "LAY", 3.00, "FAIL"), 3.30),
+			newSummaryTrade(4, 9, 17420, "BACK", 2.00, "SUCCESS"),
+			closing(newSummaryTrade(5, 9, 17420, "BACK", 2.00, "VOID"), 1.50),
+		}
+
+		s, err := strategy.NewSummary(trades, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		a := assert.New(t)
+
+		a.Equal(3, s.ClosingLine.Trades)
+		a.Equal(1, s.ClosingLine.Missing)
+		a.Equal(2, s.ClosingLine.BeatClosingLine)
+		a.InDelta(66.6667, s.ClosingLine.BeatClosingLineRate, 0.0001)
+		a.InDelta(3.3333, s.ClosingLine.AverageCLV, 0.0001)
+		a.InDelta(0.0, s.Competitions[8].ClosingLine.AverageCLV, 0.0001)
+		a.InDelta(10.0, s.Competitions[9].ClosingLine.AverageCLV, 0.0001)
+		a.Equal(1, s.Competitions[9].ClosingLine.Missing)
+	})
+
+	t.Run("returns an empty summary if no trades are provided", func(t *testing.T) {
+		t.Helper()
+
+		s, err := strategy.NewSummary([]*strategy.Trade{}, strategy.Commission{}, "")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, s.Trades)
+		assert.Equal(t, 0.0, s.StrikeRate)
+		assert.Equal(t, 0.0, s.ROI)
+		assert.Empty(t, s.Competitions)
+		assert.Empty(t, s.Seasons)
+	})
+
+	t.Run("returns an error if a trade has not been settled", func(t *testing.T) {
+		t.Helper()
+
+		trades := []*strategy.Trade{
+			newSummaryTrade(1, 8, 17420, "BACK", 3.00, "IN_PLAY"),
+		}
+
+		_, err := strategy.NewSummary(trades, strategy.Commission{}, "")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+func newSummaryTrade(day int, competitionID, seasonID uint64, side string, price float32, result strategy.Result) *strategy.Trade {
+	return &strategy.Trade{
+		MarketName:    "MATCH_ODDS",
+		RunnerName:    "Home",
+		EventID:       uint64(day),
+		CompetitionID: competitionID,
+		SeasonID:      seasonID,
+		EventDate:     time.Date(2021, 5, day, 15, 0, 0, 0, time.UTC),
+		Exchange:      "betfair",
+		Price:         price,
+		Side:          side,
+		Result:        result,
+	}
+}
@@ -0,0 +1,121 @@
+package strategy
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+// TradeWindow restricts when a Strategy is allowed to trade. MinMinutesBeforeKickoff and MaxMinutesBeforeKickoff
+// bound the time between the price and the event date. Weekdays and the inclusive KickoffHourFrom and KickoffHourTo
+// range are applied to the event date in Timezone, which defaults to UTC. An hour range where KickoffHourFrom is
+// greater than KickoffHourTo wraps around midnight. Fields that are not set are not applied.
+type TradeWindow struct {
+	MinMinutesBeforeKickoff *uint32  `json:"minMinutesBeforeKickoff"`
+	MaxMinutesBeforeKickoff *uint32  `json:"maxMinutesBeforeKickoff"`
+	Weekdays                []string `json:"weekdays"`
+	KickoffHourFrom         *uint8   `json:"kickoffHourFrom"`
+	KickoffHourTo           *uint8   `json:"kickoffHourTo"`
+	Timezone                string   `json:"timezone"`
+}
+
+// Allows returns true if a trade at the time provided for an event kicking off at the date provided falls
+// within the TradeWindow.
+func (t TradeWindow) Allows(eventDate, at time.Time) (bool, error) {
+	minutes := eventDate.Sub(at).Minutes()
+
+	if t.MinMinutesBeforeKickoff != nil && minutes < float64(*t.MinMinutesBeforeKickoff) {
+		return false, nil
+	}
+
+	if t.MaxMinutesBeforeKickoff != nil && minutes > float64(*t.MaxMinutesBeforeKickoff) {
+		return false, nil
+	}
+
+	loc, err := time.LoadLocation(t.Timezone)
+
+	if err != nil {
+		return false, fmt.Errorf("timezone %s is not supported", t.Timezone)
+	}
+
+	kickoff := eventDate.In(loc)
+
+	if len(t.Weekdays) > 0 {
+		allowed, err := weekdayAllowed(kickoff.Weekday(), t.Weekdays)
+
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+
+	return hourAllowed(kickoff.Hour(), t.KickoffHourFrom, t.KickoffHourTo)
+}
+
+func (t TradeWindow) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+func (t *TradeWindow) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &t)
+}
+
+func weekdayAllowed(day time.Weekday, weekdays []string) (bool, error) {
+	allowed := false
+
+	for _, w := range weekdays {
+		d, err := parseWeekday(w)
+
+		if err != nil {
+			return false, err
+		}
+
+		if d == day {
+			allowed = true
+		}
+	}
+
+	return allowed, nil
+}
+
+func parseWeekday(w string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToUpper(d.String()) == w {
+			return d, nil
+		}
+	}
+
+	return 0, fmt.Errorf("weekday %s is not supported", w)
+}
+
+func hourAllowed(hour int, from, to *uint8) (bool, error) {
+	if from != nil && *from > 23 {
+		return false, fmt.Errorf("kickoff hour %d must be between 0 and 23", *from)
+	}
+
+	if to != nil && *to > 23 {
+		return false, fmt.Errorf("kickoff hour %d must be between 0 and 23", *to)
+	}
+
+	if from != nil && to != nil && *from > *to {
+		return hour >= int(*from) || hour <= int(*to), nil
+	}
+
+	if from != nil && hour < int(*from) {
+		return false, nil
+	}
+
+	if to != nil && hour > int(*to) {
+		return false, nil
+	}
+
+	return true, nil
+}
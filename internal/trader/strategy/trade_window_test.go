@@ -0,0 +1,163 @@
+package strategy_test
+
+import (
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTradeWindow_Allows(t *testing.T) {
+	// Saturday 13th March 2021 15:00 UTC
+	kickoff := time.Date(2021, 3, 13, 15, 0, 0, 0, time.UTC)
+
+	t.Run("returns true if trade falls within trade window", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Window strategy.TradeWindow
+			At     time.Time
+		}{
+			{
+				Window: strategy.TradeWindow{},
+				At:     kickoff.Add(-72 * time.Hour),
+			},
+			{
+				Window: strategy.TradeWindow{
+					MinMinutesBeforeKickoff: uint32Pointer(5),
+					MaxMinutesBeforeKickoff: uint32Pointer(60),
+				},
+				At: kickoff.Add(-60 * time.Minute),
+			},
+			{
+				Window: strategy.TradeWindow{Weekdays: []string{"FRIDAY", "SATURDAY"}},
+				At:     kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{
+					KickoffHourFrom: uint8Pointer(12),
+					KickoffHourTo:   uint8Pointer(15),
+				},
+				At: kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{
+					Weekdays:        []string{"SUNDAY"},
+					KickoffHourFrom: uint8Pointer(1),
+					KickoffHourTo:   uint8Pointer(3),
+					Timezone:        "Australia/Sydney",
+				},
+				At: kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{
+					KickoffHourFrom: uint8Pointer(14),
+					KickoffHourTo:   uint8Pointer(2),
+				},
+				At: kickoff,
+			},
+		}
+
+		for i, c := range tc {
+			allowed, err := c.Window.Allows(kickoff, c.At)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.True(t, allowed, "index %d", i)
+		}
+	})
+
+	t.Run("returns false if trade falls outside of trade window", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Window strategy.TradeWindow
+			At     time.Time
+		}{
+			{
+				Window: strategy.TradeWindow{MinMinutesBeforeKickoff: uint32Pointer(5)},
+				At:     kickoff.Add(-4 * time.Minute),
+			},
+			{
+				Window: strategy.TradeWindow{MaxMinutesBeforeKickoff: uint32Pointer(60)},
+				At:     kickoff.Add(-61 * time.Minute),
+			},
+			{
+				Window: strategy.TradeWindow{Weekdays: []string{"SUNDAY"}},
+				At:     kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{KickoffHourTo: uint8Pointer(14)},
+				At:     kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{
+					KickoffHourFrom: uint8Pointer(12),
+					KickoffHourTo:   uint8Pointer(15),
+					Timezone:        "Australia/Sydney",
+				},
+				At: kickoff,
+			},
+			{
+				Window: strategy.TradeWindow{
+					KickoffHourFrom: uint8Pointer(18),
+					KickoffHourTo:   uint8Pointer(2),
+				},
+				At: kickoff,
+			},
+		}
+
+		for i, c := range tc {
+			allowed, err := c.Window.Allows(kickoff, c.At)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s at index %d", err.Error(), i)
+			}
+
+			assert.False(t, allowed, "index %d", i)
+		}
+	})
+
+	t.Run("returns an error if trade window is invalid", func(t *testing.T) {
+		t.Helper()
+
+		tc := []struct {
+			Window strategy.TradeWindow
+			Error  string
+		}{
+			{
+				Window: strategy.TradeWindow{Timezone: "Mars/Olympus"},
+				Error:  "timezone Mars/Olympus is not supported",
+			},
+			{
+				Window: strategy.TradeWindow{Weekdays: []string{"FUNDAY"}},
+				Error:  "weekday FUNDAY is not supported",
+			},
+			{
+				Window: strategy.TradeWindow{KickoffHourFrom: uint8Pointer(24)},
+				Error:  "kickoff hour 24 must be between 0 and 23",
+			},
+		}
+
+		for _, c := range tc {
+			allowed, err := c.Window.Allows(kickoff, kickoff)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			assert.False(t, allowed)
+			assert.Equal(t, c.Error, err.Error())
+		}
+	})
+}
+
+func uint32Pointer(n uint32) *uint32 {
+	return &n
+}
+
+func uint8Pointer(n uint8) *uint8 {
+	return &n
+}
@@ -11,6 +11,7 @@ import (
 const (
 	Active = "ACTIVE"
 	PercentageStakingPlan = "PERCENTAGE"
+	FixedStakingPlan      = "FIXED"
 
 	AwayTeam = "AWAY_TEAM"
 	HomeTeam = "HOME_TEAM"
@@ -19,14 +20,47 @@ const (
 	Lte     = "LTE"
 	Average = "AVERAGE"
 
-	Continuous = "CONTINUOUS"
-	Total      = "TOTAL"
+	Continuous        = "CONTINUOUS"
+	Median            = "MEDIAN"
+	Percentage        = "PERCENTAGE"
+	StandardDeviation = "STDDEV"
+	Total             = "TOTAL"
+	WeightedAverage   = "WEIGHTED_AVERAGE"
 
 	ActionFor     = "FOR"
 	ActionAgainst = "AGAINST"
 
-	Goals       = "GOALS"
-	ShotsOnGoal = "SHOTS_ON_GOAL"
+	AttacksDangerous = "ATTACKS_DANGEROUS"
+	AttacksTotal     = "ATTACKS_TOTAL"
+	Corners          = "CORNERS"
+	Fouls            = "FOULS"
+	FreeKicks        = "FREE_KICKS"
+	GoalAttempts     = "GOAL_ATTEMPTS"
+	GoalKicks        = "GOAL_KICKS"
+	Goals            = "GOALS"
+	Offsides         = "OFFSIDES"
+	PassesAccuracy   = "PASSES_ACCURACY"
+	PassesPercentage = "PASSES_PERCENTAGE"
+	PassesTotal      = "PASSES_TOTAL"
+	Possession       = "POSSESSION"
+	RedCards         = "RED_CARDS"
+	Saves            = "SAVES"
+	ShotsBlocked     = "SHOTS_BLOCKED"
+	ShotsInsideBox   = "SHOTS_INSIDE_BOX"
+	ShotsOffGoal     = "SHOTS_OFF_GOAL"
+	ShotsOnGoal      = "SHOTS_ON_GOAL"
+	ShotsOutsideBox  = "SHOTS_OUTSIDE_BOX"
+	ShotsTotal       = "SHOTS_TOTAL"
+	Substitutions    = "SUBSTITUTIONS"
+	ThrowIns         = "THROW_INS"
+	YellowCards      = "YELLOW_CARDS"
+
+	BothTeamsScored = "BOTH_TEAMS_SCORED"
+	CleanSheet      = "CLEAN_SHEET"
+	FailedToScore   = "FAILED_TO_SCORE"
+	GoalDifference  = "GOAL_DIFFERENCE"
+	Points          = "POINTS"
+	TotalGoals      = "TOTAL_GOALS"
 
 	Win      = "WIN"
 	WinDraw  = "WIN_DRAW"
@@ -38,59 +72,207 @@ const (
 	Draw = "Draw"
 	Home = "Home"
 
-	MatchOdds   = "MATCH_ODDS"
-	OverUnder05 = "OVER_UNDER_05"
-	OverUnder15 = "OVER_UNDER_15"
-	OverUnder25 = "OVER_UNDER_25"
-	OverUnder35 = "OVER_UNDER_35"
-	OverUnder45 = "OVER_UNDER_45"
+	DrawOrAway = "Draw or Away"
+	HomeOrAway = "Home or Away"
+	HomeOrDraw = "Home or Draw"
+
+	No  = "No"
+	Yes = "Yes"
+
+	AnyOtherAwayWin = "Any Other Away Win"
+	AnyOtherDraw    = "Any Other Draw"
+	AnyOtherHomeWin = "Any Other Home Win"
+
+	AsianGoalLine    = "ASIAN_GOAL_LINE"
+	AsianHandicap    = "ASIAN_HANDICAP"
+	BothTeamsToScore = "BOTH_TEAMS_TO_SCORE"
+	CorrectScore     = "CORRECT_SCORE"
+	DoubleChance     = "DOUBLE_CHANCE"
+	DrawNoBet        = "DRAW_NO_BET"
+	FirstHalfGoals05 = "FIRST_HALF_GOALS_05"
+	FirstHalfGoals15 = "FIRST_HALF_GOALS_15"
+	FirstHalfGoals25 = "FIRST_HALF_GOALS_25"
+	HalfTime         = "HALF_TIME"
+	HalfTimeFullTime = "HALF_TIME_FULL_TIME"
+	MatchOdds        = "MATCH_ODDS"
+
+	Cards         = "CARDS"
+	CardsSuffix   = "_CARDS"
+	CornersSuffix = "_CORNERS"
+
+	FullTimePeriod   = "FULL_TIME"
+	HalfTimePeriod   = "HALF_TIME"
+	SecondHalfPeriod = "SECOND_HALF"
 
-	Fail    = "FAIL"
-	Success = "SUCCESS"
+	Abandoned = "ABANDONED"
+	Awarded   = "AWARDED"
+	Postponed = "POSTPONED"
+
+	Fail     = "FAIL"
+	HalfLose = "HALF_LOSE"
+	HalfWin  = "HALF_WIN"
+	Success  = "SUCCESS"
+	Void     = "VOID"
+
+	// Unsettled is returned when settling a live trade for a fixture that does not have a final score yet.
+	Unsettled = "UNSETTLED"
 
 	Back = "BACK"
 	Lay  = "LAY"
 
 	Over  = "Over"
 	Under = "Under"
+
+	And = "AND"
+	Not = "NOT"
+	Or  = "OR"
+
+	LookbackCurrentSeason  = "CURRENT_SEASON"
+	LookbackPreviousSeason = "PREVIOUS_SEASON"
+	LookbackRolling        = "ROLLING"
+
+	Difference           = "DIFFERENCE"
+	PercentageDifference = "PERCENTAGE_DIFFERENCE"
+
+	MatchesPlayed      = "MATCHES_PLAYED"
+	RestDays           = "REST_DAYS"
+	RestDaysDifference = "REST_DAYS_DIFFERENCE"
+
+	Position           = "POSITION"
+	PositionFromBottom = "POSITION_FROM_BOTTOM"
+	PositionGap        = "POSITION_GAP"
 )
 
 type Strategy struct {
-	ID             uuid.UUID       `json:"id"`
-	Name           string          `json:"name"`
-	Description    string          `json:"description"`
-	UserID         uuid.UUID       `json:"userId"`
-	MarketName     string          `json:"market"`
-	RunnerName     string          `json:"runner"`
-	MinOdds        *float32        `json:"minOdds"`
-	MaxOdds        *float32        `json:"maxOdds"`
-	CompetitionIDs []uint64        `json:"competitionIds"`
-	Side           string          `json:"side"`
-	Visibility     string          `json:"visibility"`
-	Status         string          `json:"status"`
-	StakingPlan    StakingPlan     `json:"stakingPlan"`
-	ResultFilters  []*ResultFilter `json:"resultFilters"`
-	StatFilters    []*StatFilter   `json:"statFilters"`
-	CreatedAt      time.Time       `json:"createdAt"`
-	UpdatedAt      time.Time       `json:"updatedAt"`
+	ID                     uuid.UUID                `json:"id"`
+	Name                   string                   `json:"name"`
+	Description            string                   `json:"description"`
+	UserID                 uuid.UUID                `json:"userId"`
+	MarketName             string                   `json:"market"`
+	RunnerName             string                   `json:"runner"`
+	MinOdds                *float32                 `json:"minOdds"`
+	MaxOdds                *float32                 `json:"maxOdds"`
+	CompetitionIDs         []uint64                 `json:"competitionIds"`
+	Side                   string                   `json:"side"`
+	Visibility             string                   `json:"visibility"`
+	Status                 string                   `json:"status"`
+	StakingPlan            StakingPlan              `json:"stakingPlan"`
+	TradeWindow            TradeWindow              `json:"tradeWindow"`
+	ResultFilters          []*ResultFilter          `json:"resultFilters"`
+	StatFilters            []*StatFilter            `json:"statFilters"`
+	HeadToHeadFilters      []*HeadToHeadFilter      `json:"headToHeadFilters"`
+	LeagueTableFilters     []*LeagueTableFilter     `json:"leagueTableFilters"`
+	ComparativeStatFilters []*ComparativeStatFilter `json:"comparativeStatFilters"`
+	ScheduleFilters        []*ScheduleFilter        `json:"scheduleFilters"`
+	FilterGroups           []*FilterGroup           `json:"filterGroups"`
+	CreatedAt              time.Time                `json:"createdAt"`
+	UpdatedAt              time.Time                `json:"updatedAt"`
 }
 
+// ResultFilter and StatFilter evaluate the last Games results for a team. Lookback sets the seasons searched and
+// is one of CURRENT_SEASON (the default), ROLLING or PREVIOUS_SEASON. A filter is not matched if fewer than
+// MinGames results are found, or fewer than Games results if MinGames is zero.
+// ResultFilter Period sets the score the Result is applied to and is one of FULL_TIME (the default), HALF_TIME or
+// SECOND_HALF.
 type ResultFilter struct {
-	Team   string `json:"team"`
-	Result string `json:"result"`
-	Games  uint8  `json:"games"`
-	Venue  string `json:"venue"`
+	Team     string `json:"team"`
+	Result   string `json:"result"`
+	Games    uint8  `json:"games"`
+	Venue    string `json:"venue"`
+	Lookback string `json:"lookback"`
+	MinGames uint8  `json:"minGames"`
+	Period   string `json:"period"`
 }
 
+// StatFilter Threshold is used by the PERCENTAGE measure, where Metric and Threshold are applied to each game and
+// Value is the minimum percentage of games that must meet them. Decay is used by the WEIGHTED_AVERAGE measure.
 type StatFilter struct {
-	Stat    string  `json:"stat"`
+	Stat      string  `json:"stat"`
+	Team      string  `json:"team"`
+	Action    string  `json:"action"`
+	Games     uint8   `json:"games"`
+	Measure   string  `json:"measure"`
+	Metric    string  `json:"metric"`
+	Value     float32 `json:"value"`
+	Venue     string  `json:"venue"`
+	Lookback  string  `json:"lookback"`
+	MinGames  uint8   `json:"minGames"`
+	Threshold float32 `json:"threshold"`
+	Decay     float32 `json:"decay"`
+}
+
+// FilterGroup combines filters and nested groups using a boolean operator. AND requires every child to match,
+// OR requires at least one child to match and NOT requires the children combined using AND not to match.
+type FilterGroup struct {
+	Operator               string                   `json:"operator"`
+	ResultFilters          []*ResultFilter          `json:"resultFilters"`
+	StatFilters            []*StatFilter            `json:"statFilters"`
+	HeadToHeadFilters      []*HeadToHeadFilter      `json:"headToHeadFilters"`
+	LeagueTableFilters     []*LeagueTableFilter     `json:"leagueTableFilters"`
+	ComparativeStatFilters []*ComparativeStatFilter `json:"comparativeStatFilters"`
+	ScheduleFilters        []*ScheduleFilter        `json:"scheduleFilters"`
+	Groups                 []*FilterGroup           `json:"groups"`
+}
+
+// HeadToHeadFilter applies result and stat criteria to the previous meetings between the home and away teams of
+// a Fixture. Team sets the perspective the criteria are applied from and Venue restricts meetings to those played
+// at home or away by Team. Result and Stat are optional but at least one of them must be provided.
+type HeadToHeadFilter struct {
+	Team      string  `json:"team"`
+	Games     uint8   `json:"games"`
+	Venue     string  `json:"venue"`
+	Result    string  `json:"result"`
+	Stat      string  `json:"stat"`
+	Action    string  `json:"action"`
+	Measure   string  `json:"measure"`
+	Metric    string  `json:"metric"`
+	Value     float32 `json:"value"`
+	Threshold float32 `json:"threshold"`
+	Decay     float32 `json:"decay"`
+}
+
+// LeagueTableFilter applies criteria to the league table of a Fixture season as it stood before kick off. Measure
+// is one of POSITION, POSITION_FROM_BOTTOM or POSITION_GAP, where the gap is the opponent position minus the Team
+// position so a positive gap means Team is placed above its opponent.
+type LeagueTableFilter struct {
+	Team    string `json:"team"`
+	Measure string `json:"measure"`
+	Metric  string `json:"metric"`
+	Value   int    `json:"value"`
+}
+
+// ComparativeStatFilter compares the Measure of Stat for the home and away teams of a Fixture. DIFFERENCE compares
+// the home value minus the away value with Value, PERCENTAGE_DIFFERENCE compares the percentage the home value
+// exceeds the away value by. HomeAction and AwayAction set the perspective of each team so, for example, home goals
+// FOR can be compared with away goals AGAINST. ThresholdMetric and Threshold are applied to each game by the
+// PERCENTAGE measure, ThresholdMetric defaults to GTE. Metric and Value are applied to the comparison.
+type ComparativeStatFilter struct {
+	Stat            string  `json:"stat"`
+	HomeAction      string  `json:"homeAction"`
+	HomeVenue       string  `json:"homeVenue"`
+	AwayAction      string  `json:"awayAction"`
+	AwayVenue       string  `json:"awayVenue"`
+	Games           uint8   `json:"games"`
+	Lookback        string  `json:"lookback"`
+	MinGames        uint8   `json:"minGames"`
+	Measure         string  `json:"measure"`
+	ThresholdMetric string  `json:"thresholdMetric"`
+	Threshold       float32 `json:"threshold"`
+	Decay           float32 `json:"decay"`
+	Comparison      string  `json:"comparison"`
+	Metric          string  `json:"metric"`
+	Value           float32 `json:"value"`
+}
+
+// ScheduleFilter applies criteria to the matches played by a team before a Fixture. REST_DAYS is the number of
+// days since the team's previous match, REST_DAYS_DIFFERENCE is the team's rest days minus its opponent's and
+// MATCHES_PLAYED is the number of matches played in the Days before the Fixture.
+type ScheduleFilter struct {
 	Team    string  `json:"team"`
-	Action  string  `json:"action"`
-	Games   uint8   `json:"games"`
 	Measure string  `json:"measure"`
+	Days    uint8   `json:"days"`
 	Metric  string  `json:"metric"`
 	Value   float32 `json:"value"`
-	Venue   string  `json:"venue"`
 }
 
 type StakingPlan struct {
@@ -114,30 +296,42 @@ func (s *StakingPlan) Scan(value interface{}) error {
 type Result string
 
 type Fixture struct {
-	ID         uint64
-	HomeTeamID uint64
-	AwayTeamID uint64
-	Date       time.Time
-	SeasonID   uint64
+	ID            uint64
+	HomeTeamID    uint64
+	AwayTeamID    uint64
+	Date          time.Time
+	CompetitionID uint64
+	SeasonID      uint64
 }
 
 type MatcherQuery struct {
-	EventID       uint64
-	ResultFilters []*ResultFilter
-	StatFilters   []*StatFilter
+	EventID                uint64
+	ResultFilters          []*ResultFilter
+	StatFilters            []*StatFilter
+	HeadToHeadFilters      []*HeadToHeadFilter
+	LeagueTableFilters     []*LeagueTableFilter
+	ComparativeStatFilters []*ComparativeStatFilter
+	ScheduleFilters        []*ScheduleFilter
+	FilterGroups           []*FilterGroup
 }
 
 type BuilderQuery struct {
-	Market     string
-	Runner     string
-	MinOdds    *float32
-	MaxOdds    *float32
-	Line       string
-	Side       string
-	CompetitionIDs []uint64
-	SeasonIDs  []uint64
-	ResultFilters []*ResultFilter
-	StatFilters   []*StatFilter
+	Market                 string                   `json:"market"`
+	Runner                 string                   `json:"runner"`
+	MinOdds                *float32                 `json:"minOdds"`
+	MaxOdds                *float32                 `json:"maxOdds"`
+	Line                   string                   `json:"line"`
+	Side                   string                   `json:"side"`
+	CompetitionIDs         []uint64                 `json:"competitionIds"`
+	SeasonIDs              []uint64                 `json:"seasonIds"`
+	TradeWindow            TradeWindow              `json:"tradeWindow"`
+	ResultFilters          []*ResultFilter          `json:"resultFilters"`
+	StatFilters            []*StatFilter            `json:"statFilters"`
+	HeadToHeadFilters      []*HeadToHeadFilter      `json:"headToHeadFilters"`
+	LeagueTableFilters     []*LeagueTableFilter     `json:"leagueTableFilters"`
+	ComparativeStatFilters []*ComparativeStatFilter `json:"comparativeStatFilters"`
+	ScheduleFilters        []*ScheduleFilter        `json:"scheduleFilters"`
+	FilterGroups           []*FilterGroup           `json:"filterGroups"`
 }
 
 type Trade struct {
@@ -151,4 +345,5 @@ type Trade struct {
 	Price         float32   `json:"price"`
 	Side          string    `json:"side"`
 	Result        Result    `json:"result"`
+	ClosingPrice  *float32  `json:"closingPrice"`
 }
@@ -375,6 +375,16 @@ func (m *MockTradeWriter) Insert(t *trade.Trade) error {
 	return args.Error(0)
 }
 
+func (m *MockTradeWriter) UpdateResult(id uuid.UUID, result string) error {
+	args := m.Called(id, result)
+	return args.Error(0)
+}
+
+func (m *MockTradeWriter) UpdateClosingPrice(id uuid.UUID, price float32) error {
+	args := m.Called(id, price)
+	return args.Error(0)
+}
+
 type MockExchangeClient struct {
 	mock.Mock
 }
@@ -26,6 +26,7 @@ func (r *PostgresReader) Get(q *ReaderQuery) ([]*Trade, error) {
 	var strategyID string
 	var eventDate int64
 	var timestamp int64
+	var closingPrice sql.NullFloat64
 
 	for rows.Next() {
 		var tr Trade
@@ -44,6 +45,7 @@ func (r *PostgresReader) Get(q *ReaderQuery) ([]*Trade, error) {
 			&tr.Side,
 			&tr.Result,
 			&timestamp,
+			&closingPrice,
 		)
 
 		if err != nil {
@@ -55,6 +57,11 @@ func (r *PostgresReader) Get(q *ReaderQuery) ([]*Trade, error) {
 		tr.EventDate = time.Unix(eventDate, 0)
 		tr.Timestamp = time.Unix(timestamp, 0)
 
+		if closingPrice.Valid {
+			price := float32(closingPrice.Float64)
+			tr.ClosingPrice = &price
+		}
+
 		trades = append(trades, &tr)
 	}
 
@@ -86,13 +93,20 @@ func buildTradeQuery(db *sql.DB, q *ReaderQuery) sq.SelectBuilder {
 
 	query := builder.
 		Select("trade.*").
-		From("trade").
-		Where(sq.Eq{"strategy_id": q.StrategyID.String()})
+		From("trade")
+
+	if q.StrategyID != uuid.Nil {
+		query = query.Where(sq.Eq{"strategy_id": q.StrategyID.String()})
+	}
 
 	if len(q.Result) > 0 {
 		query = query.Where(sq.Eq{"result": q.Result})
 	}
 
+	if q.EventDateBefore != nil {
+		query = query.Where(sq.Lt{"event_date": q.EventDateBefore.Unix()})
+	}
+
 	return query
 }
 
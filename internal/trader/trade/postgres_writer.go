@@ -2,6 +2,8 @@ package trade
 
 import (
 	"database/sql"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 )
 
 type PostgresWriter struct {
@@ -27,6 +29,7 @@ func (w *PostgresWriter) Insert(t *Trade) error {
 			"side",
 			"result",
 			"timestamp",
+			"closing_price",
 		).
 		Values(
 			t.ID.String(),
@@ -42,6 +45,7 @@ func (w *PostgresWriter) Insert(t *Trade) error {
 			t.Side,
 			t.Result,
 			t.Timestamp.Unix(),
+			t.ClosingPrice,
 		).Exec()
 
 	if err != nil {
@@ -51,6 +55,30 @@ func (w *PostgresWriter) Insert(t *Trade) error {
 	return nil
 }
 
+func (w *PostgresWriter) UpdateResult(id uuid.UUID, result string) error {
+	builder := queryBuilder(w.connection)
+
+	_, err := builder.
+		Update("trade").
+		Set("result", result).
+		Where(sq.Eq{"id": id.String()}).
+		Exec()
+
+	return err
+}
+
+func (w *PostgresWriter) UpdateClosingPrice(id uuid.UUID, price float32) error {
+	builder := queryBuilder(w.connection)
+
+	_, err := builder.
+		Update("trade").
+		Set("closing_price", price).
+		Where(sq.Eq{"id": id.String()}).
+		Exec()
+
+	return err
+}
+
 func NewPostgresWriter(connection *sql.DB) Writer {
 	return &PostgresWriter{connection: connection}
 }
@@ -51,6 +51,62 @@ func TestTradeWriter_Insert(t *testing.T) {
 	})
 }
 
+func TestTradeWriter_UpdateResult(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"trade"})
+	writer := trade.NewPostgresWriter(conn)
+	reader := trade.NewPostgresReader(conn)
+
+	t.Run("updates the result of an existing trade", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		insertTrade(t, writer, tr)
+
+		if err := writer.UpdateResult(tr.ID, "VOID"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		trades, err := reader.Get(&trade.ReaderQuery{StrategyID: tr.StrategyID})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(trades))
+		assert.Equal(t, "VOID", trades[0].Result)
+	})
+}
+
+func TestTradeWriter_UpdateClosingPrice(t *testing.T) {
+	conn, cleanUp := test.GetConnection(t, []string{"trade"})
+	writer := trade.NewPostgresWriter(conn)
+	reader := trade.NewPostgresReader(conn)
+
+	t.Run("updates the closing price of an existing trade", func(t *testing.T) {
+		t.Helper()
+		defer cleanUp()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		insertTrade(t, writer, tr)
+
+		if err := writer.UpdateClosingPrice(tr.ID, 1.85); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		trades, err := reader.Get(&trade.ReaderQuery{StrategyID: tr.StrategyID})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(trades))
+		assert.Equal(t, float32(1.85), *trades[0].ClosingPrice)
+	})
+}
+
 func insertTrade(t *testing.T, w trade.Writer, tr *trade.Trade) {
 	if err := w.Insert(tr); err != nil {
 		t.Fatalf("Error inserting trade: %s", err.Error())
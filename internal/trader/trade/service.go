@@ -5,10 +5,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/statistico/statistico-trader/internal/trader/exchange"
 	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"time"
 )
 
 type Writer interface {
 	Insert(t *Trade) error
+	UpdateResult(id uuid.UUID, result string) error
+	UpdateClosingPrice(id uuid.UUID, price float32) error
 }
 
 type Reader interface {
@@ -16,9 +19,12 @@ type Reader interface {
 	Exists(market, runner string, eventID uint64, strategyID uuid.UUID) (bool, error)
 }
 
+// ReaderQuery filters trades by strategy, result and event date. A nil StrategyID returns trades for every
+// strategy.
 type ReaderQuery struct {
-	StrategyID   uuid.UUID
-	Result       []string
+	StrategyID      uuid.UUID
+	Result          []string
+	EventDateBefore *time.Time
 }
 
 type Placer interface {
@@ -31,3 +37,8 @@ type Manager interface {
 	Manage(ctx context.Context, t *Ticket, s *strategy.Strategy) error
 }
 
+type Settler interface {
+	// Settle parses the result of an IN_PLAY Trade from the final score of its event and persists the result.
+	Settle(ctx context.Context, t *Trade) error
+}
+
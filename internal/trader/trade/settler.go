@@ -0,0 +1,76 @@
+package trade
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+)
+
+type settler struct {
+	parser  strategy.ResultParser
+	closing strategy.ClosingPriceFinder
+	writer  Writer
+	logger  *logrus.Logger
+}
+
+func (s *settler) Settle(ctx context.Context, t *Trade) error {
+	if t.Result != InPlay {
+		return nil
+	}
+
+	result, err := s.parser.Parse(ctx, t.EventID, t.Market, t.Runner, t.Side)
+
+	if err != nil || result == strategy.Unsettled {
+		return err
+	}
+
+	if err := s.recordClosingPrice(ctx, t); err != nil {
+		s.logger.Errorf("error recording closing price for trade %s: %s", t.ID.String(), err.Error())
+	}
+
+	if err := s.writer.UpdateResult(t.ID, string(result)); err != nil {
+		return err
+	}
+
+	t.Result = string(result)
+
+	return nil
+}
+
+// recordClosingPrice persists the closing price of a Trade if it has not already been recorded. Trades without a
+// closing price in the odds warehouse, or whose closing price cannot be fetched or recorded, are settled without one.
+func (s *settler) recordClosingPrice(ctx context.Context, t *Trade) error {
+	if t.ClosingPrice != nil {
+		return nil
+	}
+
+	price, err := s.closing.ClosingPrice(ctx, &strategy.ClosingPriceQuery{
+		EventID:   t.EventID,
+		EventDate: t.EventDate,
+		Market:    t.Market,
+		Runner:    t.Runner,
+		Side:      t.Side,
+		Exchange:  t.Exchange,
+	})
+
+	if err != nil || price == nil {
+		return err
+	}
+
+	if err := s.writer.UpdateClosingPrice(t.ID, *price); err != nil {
+		return err
+	}
+
+	t.ClosingPrice = price
+
+	return nil
+}
+
+func NewSettler(p strategy.ResultParser, c strategy.ClosingPriceFinder, w Writer, l *logrus.Logger) Settler {
+	return &settler{
+		parser:  p,
+		closing: c,
+		writer:  w,
+		logger:  l,
+	}
+}
@@ -0,0 +1,253 @@
+package trade_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
+	"github.com/statistico/statistico-trader/internal/trader/trade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestSettler_Settle(t *testing.T) {
+	t.Run("parses trade result and updates trade via trade.Writer", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+		tr.Market = "DRAW_NO_BET"
+
+		price := float32(1.85)
+
+		parser.On("Parse", ctx, tr.EventID, "DRAW_NO_BET", "Home", "BACK").Return(strategy.Result("VOID"), nil)
+		closing.On("ClosingPrice", ctx, closingPriceQuery(tr)).Return(&price, nil)
+		writer.On("UpdateClosingPrice", tr.ID, float32(1.85)).Return(nil)
+		writer.On("UpdateResult", tr.ID, "VOID").Return(nil)
+
+		err := settler.Settle(ctx, tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "VOID", tr.Result)
+		assert.Equal(t, float32(1.85), *tr.ClosingPrice)
+		parser.AssertExpectations(t)
+		closing.AssertExpectations(t)
+		writer.AssertExpectations(t)
+	})
+
+	t.Run("settles trade without a closing price if the odds warehouse does not contain one", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		parser.On("Parse", ctx, tr.EventID, "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("SUCCESS"), nil)
+		closing.On("ClosingPrice", ctx, closingPriceQuery(tr)).Return(nil, nil)
+		writer.On("UpdateResult", tr.ID, "SUCCESS").Return(nil)
+
+		err := settler.Settle(ctx, tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "SUCCESS", tr.Result)
+		assert.Nil(t, tr.ClosingPrice)
+		writer.AssertNotCalled(t, "UpdateClosingPrice", mock.Anything, mock.Anything)
+		writer.AssertExpectations(t)
+	})
+
+	t.Run("does not fetch closing price if the closing price has already been recorded", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		price := float32(1.85)
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+		tr.ClosingPrice = &price
+
+		parser.On("Parse", ctx, tr.EventID, "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("FAIL"), nil)
+		writer.On("UpdateResult", tr.ID, "FAIL").Return(nil)
+
+		err := settler.Settle(ctx, tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "FAIL", tr.Result)
+		closing.AssertNotCalled(t, "ClosingPrice", mock.Anything, mock.Anything)
+		writer.AssertNotCalled(t, "UpdateClosingPrice", mock.Anything, mock.Anything)
+	})
+
+	t.Run("logs error and settles trade if error returned by closing price finder", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, hook := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		parser.On("Parse", ctx, tr.EventID, "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("SUCCESS"), nil)
+		closing.On("ClosingPrice", ctx, closingPriceQuery(tr)).
+			Return(nil, errors.New("error fetching closing price for event 281781: odds warehouse unavailable"))
+		writer.On("UpdateResult", tr.ID, "SUCCESS").Return(nil)
+
+		err := settler.Settle(ctx, tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "SUCCESS", tr.Result)
+		assert.Nil(t, tr.ClosingPrice)
+		assert.Equal(
+			t,
+			"error recording closing price for trade "+tr.ID.String()+": error fetching closing price for event 281781: odds warehouse unavailable",
+			hook.LastEntry().Message,
+		)
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		writer.AssertExpectations(t)
+		writer.AssertNotCalled(t, "UpdateClosingPrice", mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not settle a trade that is not in play", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		tr := newTrade(uuid.New(), "SUCCESS")
+
+		err := settler.Settle(context.Background(), tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		parser.AssertNotCalled(t, "Parse", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		writer.AssertNotCalled(t, "UpdateResult", mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not settle a trade if the result is unsettled", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		parser.On("Parse", ctx, tr.EventID, "MATCH_ODDS", "Home", "BACK").Return(strategy.Result("UNSETTLED"), nil)
+
+		err := settler.Settle(ctx, tr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "IN_PLAY", tr.Result)
+		closing.AssertNotCalled(t, "ClosingPrice", mock.Anything, mock.Anything)
+		writer.AssertNotCalled(t, "UpdateResult", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns error if error returned by result parser", func(t *testing.T) {
+		t.Helper()
+
+		parser := new(MockResultParser)
+		closing := new(MockClosingPriceFinder)
+		writer := new(MockTradeWriter)
+		logger, _ := test.NewNullLogger()
+		settler := trade.NewSettler(parser, closing, writer, logger)
+
+		ctx := context.Background()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		parser.On("Parse", ctx, tr.EventID, "MATCH_ODDS", "Home", "BACK").
+			Return(strategy.Result(""), errors.New("unable to parse match stats for fixture 281781"))
+
+		err := settler.Settle(ctx, tr)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "unable to parse match stats for fixture 281781", err.Error())
+		assert.Equal(t, "IN_PLAY", tr.Result)
+		writer.AssertNotCalled(t, "UpdateResult", mock.Anything, mock.Anything)
+	})
+}
+
+type MockResultParser struct {
+	mock.Mock
+}
+
+func (m *MockResultParser) Parse(ctx context.Context, eventID uint64, market, runner, side string) (strategy.Result, error) {
+	args := m.Called(ctx, eventID, market, runner, side)
+	return args.Get(0).(strategy.Result), args.Error(1)
+}
+
+type MockClosingPriceFinder struct {
+	mock.Mock
+}
+
+func (m *MockClosingPriceFinder) ClosingPrice(ctx context.Context, q *strategy.ClosingPriceQuery) (*float32, error) {
+	args := m.Called(ctx, q)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*float32), args.Error(1)
+}
+
+func closingPriceQuery(tr *trade.Trade) *strategy.ClosingPriceQuery {
+	return &strategy.ClosingPriceQuery{
+		EventID:   tr.EventID,
+		EventDate: tr.EventDate,
+		Market:    tr.Market,
+		Runner:    tr.Runner,
+		Side:      tr.Side,
+		Exchange:  tr.Exchange,
+	}
+}
@@ -1,7 +1,9 @@
 package trade
 
 import (
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/statistico/statistico-trader/internal/trader/strategy"
 	"time"
 )
 
@@ -9,20 +11,22 @@ const (
 	InPlay = "IN_PLAY"
 )
 
+
 type Trade struct {
-	ID          uuid.UUID `json:"id"`
-	StrategyID  uuid.UUID `json:"strategyId"`
-	Exchange    string    `json:"exchange"`
-	ExchangeRef string    `json:"exchangeRef"`
-	Market      string    `json:"market"`
-	Runner      string    `json:"runner"`
-	Price       float32   `json:"price"`
-	Stake       float32   `json:"stake"`
-	EventID     uint64    `json:"eventId"`
-	EventDate   time.Time `json:"eventDate"`
-	Side        string    `json:"side"`
-	Result      string    `json:"result"`
-	Timestamp   time.Time `json:"timestamp"`
+	ID           uuid.UUID `json:"id"`
+	StrategyID   uuid.UUID `json:"strategyId"`
+	Exchange     string    `json:"exchange"`
+	ExchangeRef  string    `json:"exchangeRef"`
+	Market       string    `json:"market"`
+	Runner       string    `json:"runner"`
+	Price        float32   `json:"price"`
+	Stake        float32   `json:"stake"`
+	EventID      uint64    `json:"eventId"`
+	EventDate    time.Time `json:"eventDate"`
+	Side         string    `json:"side"`
+	Result       string    `json:"result"`
+	Timestamp    time.Time `json:"timestamp"`
+	ClosingPrice *float32  `json:"closingPrice"`
 }
 
 type Ticket struct {
@@ -43,3 +47,41 @@ type TicketPrice struct {
 	Size      float32   `json:"size"`
 	Side      string    `json:"side"`
 }
+
+// Profit returns the profit or loss of a settled Trade.
+func (t *Trade) Profit() (float32, error) {
+	if t.Result == InPlay {
+		return 0, fmt.Errorf("trade %s has not been settled", t.ID)
+	}
+
+	return strategy.CalculateProfit(t.Side, t.Price, t.Stake, strategy.Result(t.Result))
+}
+
+// NetProfit returns the profit or loss of a settled Trade after deducting commission at the percentage rate
+// provided.
+func (t *Trade) NetProfit(rate float32) (float32, error) {
+	profit, err := t.Profit()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return strategy.CalculateNetProfit(profit, rate), nil
+}
+
+// ClosingLineValue returns the percentage by which the Trade beat the closing price or nil if the closing price
+// has not been recorded.
+func (t *Trade) ClosingLineValue() *float64 {
+	if t.ClosingPrice == nil || *t.ClosingPrice <= 1 || t.Price <= 1 {
+		return nil
+	}
+
+	clv := strategy.CalculateClosingLineValue(t.Side, t.Price, *t.ClosingPrice)
+
+	return &clv
+}
+
+// Liability returns the amount at risk on a Trade, which is the stake multiplied by price minus one for Lay trades.
+func (t *Trade) Liability() float32 {
+	return strategy.CalculateLiability(t.Side, t.Price, t.Stake)
+}
@@ -0,0 +1,120 @@
+package trade_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrade_Profit(t *testing.T) {
+	t.Run("returns profit of settled trade", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "HALF_WIN")
+
+		profit, err := tr.Profit()
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float32(45), profit)
+	})
+
+	t.Run("returns an error if trade is in play", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		_, err := tr.Profit()
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "trade "+tr.ID.String()+" has not been settled", err.Error())
+	})
+}
+
+func TestTrade_NetProfit(t *testing.T) {
+	t.Run("returns profit of settled trade after commission", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "SUCCESS")
+
+		profit, err := tr.NetProfit(5)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.InDelta(t, 85.5, profit, 0.001)
+	})
+
+	t.Run("does not charge commission on losing trades", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "FAIL")
+
+		profit, err := tr.NetProfit(5)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float32(-100), profit)
+	})
+
+	t.Run("returns an error if trade is in play", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		_, err := tr.NetProfit(5)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "trade "+tr.ID.String()+" has not been settled", err.Error())
+	})
+}
+
+func TestTrade_Liability(t *testing.T) {
+	t.Run("returns the stake for back trades and the liability for lay trades", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "IN_PLAY")
+
+		assert.Equal(t, float32(100), tr.Liability())
+
+		tr.Side = "LAY"
+
+		assert.InDelta(t, 90, tr.Liability(), 0.001)
+	})
+}
+
+func TestTrade_ClosingLineValue(t *testing.T) {
+	t.Run("returns the percentage by which the trade beat the closing price", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "SUCCESS")
+
+		closing := float32(1.80)
+		tr.ClosingPrice = &closing
+
+		assert.InDelta(t, 5.5556, *tr.ClosingLineValue(), 0.0001)
+
+		tr.Side = "LAY"
+
+		assert.InDelta(t, -5.2632, *tr.ClosingLineValue(), 0.0001)
+	})
+
+	t.Run("returns nil if the closing price has not been recorded", func(t *testing.T) {
+		t.Helper()
+
+		tr := newTrade(uuid.New(), "SUCCESS")
+
+		assert.Nil(t, tr.ClosingLineValue())
+	})
+}